@@ -13,12 +13,15 @@ import (
 
 	"github.com/fusionn-air/internal/client/apprise"
 	"github.com/fusionn-air/internal/client/emby"
+	"github.com/fusionn-air/internal/client/jellyfin"
 	"github.com/fusionn-air/internal/client/overseerr"
+	"github.com/fusionn-air/internal/client/plex"
 	"github.com/fusionn-air/internal/client/radarr"
 	"github.com/fusionn-air/internal/client/sonarr"
 	"github.com/fusionn-air/internal/client/trakt"
 	"github.com/fusionn-air/internal/config"
 	"github.com/fusionn-air/internal/handler"
+	"github.com/fusionn-air/internal/history"
 	"github.com/fusionn-air/internal/scheduler"
 	"github.com/fusionn-air/internal/service/cleanup"
 	"github.com/fusionn-air/internal/service/watcher"
@@ -32,16 +35,20 @@ func main() {
 	logger.Init(isDev)
 	defer logger.Sync()
 
+	// Subcommands run once and exit
+	if len(os.Args) > 1 && os.Args[1] == "restore" {
+		code := runRestore(os.Args[2:])
+		logger.Sync()
+		os.Exit(code)
+	}
+
 	version.PrintBanner(nil)
 
 	// Load configuration
-	configPath := os.Getenv("CONFIG_PATH")
-	if configPath == "" {
-		configPath = "config/config.yaml"
-	}
+	cfgPath := configPath()
 
-	logger.Infof("📁 Loading config: %s", configPath)
-	cfgMgr, err := config.NewManager(configPath)
+	logger.Infof("📁 Loading config: %s", cfgPath)
+	cfgMgr, err := config.NewManager(cfgPath)
 	if err != nil {
 		logger.Fatalf("❌ Config error: %v", err)
 	}
@@ -62,6 +69,18 @@ func main() {
 	}
 	logger.Info("✅  Trakt connected")
 
+	// Initialize additional Trakt accounts (each with its own token file)
+	var traktUsers []*trakt.Client
+	for _, user := range cfg.Trakt.Users {
+		logger.Infof("🔗 Connecting to Trakt as %s...", user.Name)
+		userClient := trakt.NewUserClient(cfg.Trakt, user)
+		if err := userClient.Initialize(ctx); err != nil {
+			logger.Fatalf("❌ Trakt auth failed for %s: %v", user.Name, err)
+		}
+		traktUsers = append(traktUsers, userClient)
+		logger.Infof("✅  Trakt connected as %s", user.Name)
+	}
+
 	// Initialize Overseerr client
 	logger.Info("🔗 Connecting to Overseerr...")
 	overseerrClient := overseerr.NewClient(cfg.Overseerr)
@@ -80,10 +99,18 @@ func main() {
 		logger.Info("🔔 Notifications: disabled")
 	}
 
+	// Open run history (shared by watcher and cleanup)
+	historyStore, err := history.Open("data/history.jsonl")
+	if err != nil {
+		logger.Warnf("⚠️  Run history unavailable: %v", err)
+	}
+
 	// Initialize Sonarr and Radarr clients (if cleanup enabled)
 	var sonarrClient *sonarr.Client
 	var radarrClient *radarr.Client
 	var embyClient *emby.Client
+	var jellyfinClient *jellyfin.Client
+	var plexClient *plex.Client
 	var cleanupService *cleanup.Service
 
 	if cfg.Cleanup.Enabled {
@@ -109,7 +136,35 @@ func main() {
 			}
 		}
 
-		cleanupService = cleanup.NewService(sonarrClient, radarrClient, embyClient, traktClient, appriseClient, cfgMgr)
+		if cfg.Jellyfin.Enabled {
+			if cfg.Jellyfin.BaseURL != "" && cfg.Jellyfin.APIKey != "" {
+				logger.Info("🔗 Connecting to Jellyfin...")
+				jellyfinClient = jellyfin.NewClient(cfg.Jellyfin)
+				logger.Info("✅  Jellyfin configured")
+			} else {
+				logger.Warn("⚠️  Jellyfin enabled but base_url or api_key is empty — skipping")
+			}
+		}
+
+		if cfg.Plex.Enabled {
+			if cfg.Plex.BaseURL != "" && cfg.Plex.Token != "" {
+				logger.Info("🔗 Connecting to Plex...")
+				plexClient = plex.NewClient(cfg.Plex)
+				logger.Info("✅  Plex configured")
+			} else {
+				logger.Warn("⚠️  Plex enabled but base_url or token is empty — skipping")
+			}
+		}
+
+		queueStore, err := cleanup.OpenFileQueueStore(cleanupQueueFile)
+		if err != nil {
+			logger.Fatalf("❌ Cleanup queue error: %v", err)
+		}
+
+		cleanupService, err = cleanup.NewService(sonarrClient, radarrClient, embyClient, jellyfinClient, plexClient, traktClient, traktUsers, appriseClient, cfgMgr, queueStore, historyStore)
+		if err != nil {
+			logger.Fatalf("❌ Cleanup queue error: %v", err)
+		}
 		logger.Infof("🧹 Cleanup: enabled (delay=%d days)", cfg.Cleanup.DelayDays)
 	} else {
 		logger.Info("🧹 Cleanup: disabled")
@@ -118,7 +173,12 @@ func main() {
 	// Initialize watcher service
 	var watcherService *watcher.Service
 	if cfg.Watcher.Enabled {
-		watcherService = watcher.NewService(traktClient, overseerrClient, appriseClient, cfgMgr)
+		// Backfill skips seasons cleanup deleted on purpose
+		var deletions watcher.DeletionLedger
+		if cleanupService != nil {
+			deletions = cleanupService
+		}
+		watcherService = watcher.NewService(traktClient, traktUsers, overseerrClient, appriseClient, cfgMgr, historyStore, deletions)
 		logger.Infof("👁️  Watcher: enabled (calendar_days=%d)", cfg.Watcher.CalendarDays)
 	} else {
 		logger.Info("👁️  Watcher: disabled")
@@ -139,7 +199,7 @@ func main() {
 	router.Use(gin.Recovery())
 	router.Use(requestLogger())
 
-	h := handler.New(watcherService, cleanupService, sched)
+	h := handler.New(watcherService, cleanupService, sched, historyStore)
 	h.RegisterRoutes(router)
 
 	srv := &http.Server{
@@ -189,6 +249,17 @@ func main() {
 	logger.Info("👋 Goodbye!")
 }
 
+// cleanupQueueFile holds every cleanup queue
+const cleanupQueueFile = "data/cleanup_queues.json"
+
+// configPath returns the config file path (CONFIG_PATH or the default)
+func configPath() string {
+	if path := os.Getenv("CONFIG_PATH"); path != "" {
+		return path
+	}
+	return "config/config.yaml"
+}
+
 // requestLogger returns a gin middleware for logging HTTP requests
 func requestLogger() gin.HandlerFunc {
 	return func(c *gin.Context) {
@@ -0,0 +1,161 @@
+package main
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"net/http"
+	"os"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/fusionn-air/internal/client/sonarr"
+	"github.com/fusionn-air/internal/config"
+	"github.com/fusionn-air/internal/service/cleanup"
+	"github.com/fusionn-air/pkg/logger"
+)
+
+const restoreUsage = `Usage:
+  fusionn-air restore list
+  fusionn-air restore series <tvdb-id>
+  fusionn-air restore movie <tmdb-id>
+
+Talks to the running server (SERVER_URL, default http://localhost:<server.port>).
+If the server is down, "list" reads the ledger file instead.`
+
+// cleanupLedgerFile is the deletion ledger written by the cleanup service
+const cleanupLedgerFile = "data/cleanup_ledger.json"
+
+// errServerDown means the server couldn't be reached at all
+var errServerDown = errors.New("server not reachable")
+
+// runRestore lists the deletion ledger or re-adds a deleted item to
+// Sonarr/Radarr through the running server, returning the process exit code.
+// The server owns the cleanup files, so the CLI never writes them itself.
+func runRestore(args []string) int {
+	if len(args) == 0 || (args[0] != "list" && len(args) != 2) {
+		fmt.Fprintln(os.Stderr, restoreUsage)
+		return 2
+	}
+
+	cfg, err := config.Load(configPath())
+	if err != nil {
+		logger.Errorf("❌ Config error: %v", err)
+		return 1
+	}
+	baseURL := serverURL(cfg)
+
+	ctx, cancel := context.WithTimeout(context.Background(), time.Minute)
+	defer cancel()
+
+	if args[0] == "list" {
+		var resp struct {
+			Enabled bool                   `json:"enabled"`
+			Ledger  []*cleanup.LedgerEntry `json:"ledger"`
+		}
+		err := callServer(ctx, http.MethodGet, baseURL+"/api/v1/cleanup/ledger", &resp)
+		switch {
+		case errors.Is(err, errServerDown):
+			// Reading the ledger file is safe without the server
+			logger.Warnf("⚠️  %v, reading %s", err, cleanupLedgerFile)
+			resp.Ledger = cleanup.NewLedgerWithFile(cleanupLedgerFile).GetAll()
+		case err != nil:
+			logger.Errorf("❌ Listing ledger failed: %v", err)
+			return 1
+		case !resp.Enabled:
+			logger.Warn("⚠️  Cleanup is disabled on the server")
+			return 1
+		}
+		printLedger(resp.Ledger)
+		return 0
+	}
+
+	mediaType := cleanup.MediaType(args[0])
+	if mediaType != cleanup.MediaTypeSeries && mediaType != cleanup.MediaTypeMovie {
+		fmt.Fprintln(os.Stderr, restoreUsage)
+		return 2
+	}
+
+	id, err := strconv.Atoi(args[1])
+	if err != nil || id <= 0 {
+		fmt.Fprintln(os.Stderr, restoreUsage)
+		return 2
+	}
+
+	var resp struct {
+		Enabled *bool                `json:"enabled"`
+		Item    *cleanup.LedgerEntry `json:"item"`
+	}
+	url := fmt.Sprintf("%s/api/v1/cleanup/restore/%s/%d", baseURL, mediaType, id)
+	if err := callServer(ctx, http.MethodPost, url, &resp); err != nil {
+		if errors.Is(err, errServerDown) {
+			logger.Errorf("❌ Restore failed: %v - start fusionn-air first", err)
+		} else {
+			logger.Errorf("❌ Restore failed: %v", err)
+		}
+		return 1
+	}
+	if resp.Enabled != nil && !*resp.Enabled {
+		logger.Warn("⚠️  Cleanup is disabled on the server")
+		return 1
+	}
+	if resp.Item != nil {
+		logger.Infof("✅ Restored %s", resp.Item.Title)
+	}
+	return 0
+}
+
+// serverURL returns the API server's base URL (SERVER_URL or localhost)
+func serverURL(cfg *config.Config) string {
+	if url := os.Getenv("SERVER_URL"); url != "" {
+		return strings.TrimSuffix(url, "/")
+	}
+	return fmt.Sprintf("http://localhost:%d", cfg.Server.Port)
+}
+
+// callServer calls the API and decodes the JSON response into out. API
+// errors are returned with the server's error message.
+func callServer(ctx context.Context, method, url string, out any) error {
+	req, err := http.NewRequestWithContext(ctx, method, url, nil)
+	if err != nil {
+		return fmt.Errorf("creating request: %w", err)
+	}
+
+	resp, err := http.DefaultClient.Do(req)
+	if err != nil {
+		return fmt.Errorf("%w: %v", errServerDown, err)
+	}
+	defer resp.Body.Close()
+
+	if resp.StatusCode != http.StatusOK {
+		var apiErr struct {
+			Error string `json:"error"`
+		}
+		if json.NewDecoder(resp.Body).Decode(&apiErr) == nil && apiErr.Error != "" {
+			return fmt.Errorf("API error: status=%d: %s", resp.StatusCode, apiErr.Error)
+		}
+		return fmt.Errorf("API error: status=%d", resp.StatusCode)
+	}
+
+	if err := json.NewDecoder(resp.Body).Decode(out); err != nil {
+		return fmt.Errorf("decoding response: %w", err)
+	}
+	return nil
+}
+
+func printLedger(entries []*cleanup.LedgerEntry) {
+	if len(entries) == 0 {
+		fmt.Println("Deletion ledger is empty")
+		return
+	}
+	for _, e := range entries {
+		restored := ""
+		if e.RestoredAt != nil {
+			restored = fmt.Sprintf(" (restored %s)", e.RestoredAt.Format(time.DateOnly))
+		}
+		fmt.Printf("%-7s %-8d %s  deleted %s  %s%s\n",
+			e.Type, e.ExternalID, e.Title, e.DeletedAt.Format(time.DateOnly), sonarr.FormatSize(e.SizeOnDisk), restored)
+	}
+}
@@ -15,13 +15,16 @@ func (f *SlackFormatter) FormatWatcherResults(requested, skipped, errors int, de
 	// Categorize
 	var requestedItems []WatcherDetail
 	var skippedItems []WatcherDetail
+	var declinedItems []WatcherDetail
 	var errorItems []WatcherDetail
 
 	for _, d := range details {
 		switch d.Action {
 		case "requested", "dry_run":
 			requestedItems = append(requestedItems, d)
-		case "error":
+		case "declined":
+			declinedItems = append(declinedItems, d)
+		case "error", "failed":
 			errorItems = append(errorItems, d)
 		default:
 			skippedItems = append(skippedItems, d)
@@ -36,7 +39,10 @@ func (f *SlackFormatter) FormatWatcherResults(requested, skipped, errors int, de
 			if item.Route != "" {
 				routeTag = fmt.Sprintf(" [→ %s]", item.Route)
 			}
-			fmt.Fprintf(&sb, "• %s S%02d ← %s%s\n", item.ShowTitle, item.Season, item.Reason, routeTag)
+			if item.User != "" {
+				routeTag += fmt.Sprintf(" [for %s]", item.User)
+			}
+			fmt.Fprintf(&sb, "• %s ← %s%s\n", item.label(), item.Reason, routeTag)
 		}
 		sb.WriteString("\n")
 	}
@@ -45,7 +51,16 @@ func (f *SlackFormatter) FormatWatcherResults(requested, skipped, errors int, de
 	if len(skippedItems) > 0 {
 		fmt.Fprintf(&sb, "*SKIPPED (%d):*\n", len(skippedItems))
 		for _, item := range skippedItems {
-			fmt.Fprintf(&sb, "• %s S%02d ← %s\n", item.ShowTitle, item.Season, item.Reason)
+			fmt.Fprintf(&sb, "• %s ← %s\n", item.label(), item.Reason)
+		}
+		sb.WriteString("\n")
+	}
+
+	// Declined section
+	if len(declinedItems) > 0 {
+		fmt.Fprintf(&sb, "*🚫 DECLINED (%d):*\n", len(declinedItems))
+		for _, item := range declinedItems {
+			fmt.Fprintf(&sb, "• %s ← %s\n", item.label(), item.Reason)
 		}
 		sb.WriteString("\n")
 	}
@@ -54,7 +69,7 @@ func (f *SlackFormatter) FormatWatcherResults(requested, skipped, errors int, de
 	if len(errorItems) > 0 {
 		fmt.Fprintf(&sb, "*ERRORS (%d):*\n", len(errorItems))
 		for _, item := range errorItems {
-			fmt.Fprintf(&sb, "• %s S%02d ← %s\n", item.ShowTitle, item.Season, item.Reason)
+			fmt.Fprintf(&sb, "• %s ← %s\n", item.label(), item.Reason)
 		}
 	}
 
@@ -73,6 +88,10 @@ func (f *SlackFormatter) FormatCleanupResults(removed, queued, skipped, errors i
 	movieDetails := make(map[string][]CleanupDetail)
 	embySeriesDetails := make(map[string][]CleanupDetail)
 	embyMovieDetails := make(map[string][]CleanupDetail)
+	jellyfinSeriesDetails := make(map[string][]CleanupDetail)
+	jellyfinMovieDetails := make(map[string][]CleanupDetail)
+	plexSeriesDetails := make(map[string][]CleanupDetail)
+	plexMovieDetails := make(map[string][]CleanupDetail)
 
 	for _, d := range details {
 		switch d.MediaType {
@@ -82,6 +101,14 @@ func (f *SlackFormatter) FormatCleanupResults(removed, queued, skipped, errors i
 			embySeriesDetails[d.Action] = append(embySeriesDetails[d.Action], d)
 		case "emby_movie":
 			embyMovieDetails[d.Action] = append(embyMovieDetails[d.Action], d)
+		case "jellyfin_series":
+			jellyfinSeriesDetails[d.Action] = append(jellyfinSeriesDetails[d.Action], d)
+		case "jellyfin_movie":
+			jellyfinMovieDetails[d.Action] = append(jellyfinMovieDetails[d.Action], d)
+		case "plex_series":
+			plexSeriesDetails[d.Action] = append(plexSeriesDetails[d.Action], d)
+		case "plex_movie":
+			plexMovieDetails[d.Action] = append(plexMovieDetails[d.Action], d)
 		default:
 			seriesDetails[d.Action] = append(seriesDetails[d.Action], d)
 		}
@@ -91,6 +118,10 @@ func (f *SlackFormatter) FormatCleanupResults(removed, queued, skipped, errors i
 	f.formatMediaTypeSection(&sb, "🎬 MOVIES (Radarr)", movieDetails, dryRun)
 	f.formatMediaTypeSection(&sb, "📺 SERIES (Emby)", embySeriesDetails, dryRun)
 	f.formatMediaTypeSection(&sb, "🎬 MOVIES (Emby)", embyMovieDetails, dryRun)
+	f.formatMediaTypeSection(&sb, "📺 SERIES (Jellyfin)", jellyfinSeriesDetails, dryRun)
+	f.formatMediaTypeSection(&sb, "🎬 MOVIES (Jellyfin)", jellyfinMovieDetails, dryRun)
+	f.formatMediaTypeSection(&sb, "📺 SERIES (Plex)", plexSeriesDetails, dryRun)
+	f.formatMediaTypeSection(&sb, "🎬 MOVIES (Plex)", plexMovieDetails, dryRun)
 
 	return sb.String()
 }
@@ -167,11 +198,25 @@ func (f *SlackFormatter) formatMediaTypeSection(sb *strings.Builder, header stri
 
 // WatcherDetail represents a single watcher result item
 type WatcherDetail struct {
+	MediaType string // "movie" or "" (season)
 	ShowTitle string
+	Year      int // Movies only
 	Season    int
 	Action    string
 	Reason    string
-	Route     string // "default", "alternate", or "" (no routing)
+	Route     string // Routing rule name, "default", "alternate", or "" (no routing)
+	User      string // Trakt account that triggered the request ("" = single account)
+}
+
+// label names the season or movie
+func (d WatcherDetail) label() string {
+	if d.MediaType == "movie" {
+		if d.Year > 0 {
+			return fmt.Sprintf("%s (%d)", d.ShowTitle, d.Year)
+		}
+		return d.ShowTitle
+	}
+	return fmt.Sprintf("%s S%02d", d.ShowTitle, d.Season)
 }
 
 // CleanupDetail represents a single cleanup result item
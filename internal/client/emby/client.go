@@ -3,6 +3,7 @@ package emby
 import (
 	"context"
 	"fmt"
+	"strings"
 	"time"
 
 	"github.com/go-resty/resty/v2"
@@ -56,7 +57,7 @@ func (c *Client) GetSeries(ctx context.Context, parentID string) ([]Item, error)
 		SetResult(&resp).
 		SetQueryParam("IncludeItemTypes", "Series").
 		SetQueryParam("Recursive", "true").
-		SetQueryParam("Fields", "ProviderIds,Path,ParentId")
+		SetQueryParam("Fields", "ProviderIds,Path,ParentId,Genres,Studios,ProductionYear")
 
 	if parentID != "" {
 		req.SetQueryParam("ParentId", parentID)
@@ -86,7 +87,7 @@ func (c *Client) GetMovies(ctx context.Context, parentID string) ([]Item, error)
 		SetResult(&resp).
 		SetQueryParam("IncludeItemTypes", "Movie").
 		SetQueryParam("Recursive", "true").
-		SetQueryParam("Fields", "ProviderIds,Path,ParentId")
+		SetQueryParam("Fields", "ProviderIds,Path,ParentId,Genres,Studios,ProductionYear")
 
 	if parentID != "" {
 		req.SetQueryParam("ParentId", parentID)
@@ -147,6 +148,109 @@ func (c *Client) GetEpisodes(ctx context.Context, seriesID, seasonID string) ([]
 	return resp.Items, nil
 }
 
+// GetUsers returns all Emby users (requires an admin API key)
+func (c *Client) GetUsers(ctx context.Context) ([]User, error) {
+	var users []User
+	r, err := c.client.R().
+		SetContext(ctx).
+		SetResult(&users).
+		Get("/Users")
+
+	if err != nil {
+		return nil, fmt.Errorf("getting users: %w", err)
+	}
+
+	if r.IsError() {
+		return nil, fmt.Errorf("API error: status=%d", r.StatusCode())
+	}
+
+	return users, nil
+}
+
+// GetPlayedItems returns all items of the given type the user has played
+func (c *Client) GetPlayedItems(ctx context.Context, userID, itemType string) ([]Item, error) {
+	var resp ItemsResponse
+	r, err := c.client.R().
+		SetContext(ctx).
+		SetResult(&resp).
+		SetQueryParam("IncludeItemTypes", itemType).
+		SetQueryParam("Recursive", "true").
+		SetQueryParam("IsPlayed", "true").
+		SetQueryParam("Fields", "ProviderIds,ProductionYear").
+		Get(fmt.Sprintf("/Users/%s/Items", userID))
+
+	if err != nil {
+		return nil, fmt.Errorf("getting played items: %w", err)
+	}
+
+	if r.IsError() {
+		return nil, fmt.Errorf("API error: status=%d", r.StatusCode())
+	}
+
+	return resp.Items, nil
+}
+
+// GetUserEpisodes returns all episodes of a series with the user's playback state
+func (c *Client) GetUserEpisodes(ctx context.Context, seriesID, userID string) ([]Item, error) {
+	var resp ItemsResponse
+	r, err := c.client.R().
+		SetContext(ctx).
+		SetResult(&resp).
+		SetQueryParam("UserId", userID).
+		SetQueryParam("Fields", "LocationType,PremiereDate").
+		Get(fmt.Sprintf("/Shows/%s/Episodes", seriesID))
+
+	if err != nil {
+		return nil, fmt.Errorf("getting user episodes: %w", err)
+	}
+
+	if r.IsError() {
+		return nil, fmt.Errorf("API error: status=%d", r.StatusCode())
+	}
+
+	return resp.Items, nil
+}
+
+// GetSessions returns the active sessions, including what each is playing
+func (c *Client) GetSessions(ctx context.Context) ([]Session, error) {
+	var sessions []Session
+	r, err := c.client.R().
+		SetContext(ctx).
+		SetResult(&sessions).
+		Get("/Sessions")
+
+	if err != nil {
+		return nil, fmt.Errorf("getting sessions: %w", err)
+	}
+
+	if r.IsError() {
+		return nil, fmt.Errorf("API error: status=%d", r.StatusCode())
+	}
+
+	return sessions, nil
+}
+
+// GetItemsByID returns the given items with their provider IDs
+func (c *Client) GetItemsByID(ctx context.Context, ids []string) ([]Item, error) {
+	var resp ItemsResponse
+	r, err := c.client.R().
+		SetContext(ctx).
+		SetResult(&resp).
+		SetQueryParam("Ids", strings.Join(ids, ",")).
+		SetQueryParam("Fields", "ProviderIds").
+		Get("/Items")
+
+	if err != nil {
+		return nil, fmt.Errorf("getting items: %w", err)
+	}
+
+	if r.IsError() {
+		return nil, fmt.Errorf("API error: status=%d", r.StatusCode())
+	}
+
+	return resp.Items, nil
+}
+
 func (c *Client) DeleteItem(ctx context.Context, itemID string) error {
 	r, err := c.client.R().
 		SetContext(ctx).
@@ -1,6 +1,9 @@
 package emby
 
-import "strconv"
+import (
+	"strconv"
+	"time"
+)
 
 type ItemsResponse struct {
 	Items            []Item `json:"Items"`
@@ -17,6 +20,39 @@ type Item struct {
 	IndexNumber  int         `json:"IndexNumber"`
 	LocationType string      `json:"LocationType"`
 	IsFolder     bool        `json:"IsFolder"`
+	Genres       []string    `json:"Genres,omitempty"`
+	Studios      []Studio    `json:"Studios,omitempty"` // Network for series
+
+	// Populated for user-scoped queries (watch state)
+	SeriesID          string     `json:"SeriesId,omitempty"`
+	SeriesName        string     `json:"SeriesName,omitempty"`
+	ParentIndexNumber int        `json:"ParentIndexNumber,omitempty"` // Season number for episodes
+	ProductionYear    int        `json:"ProductionYear,omitempty"`
+	PremiereDate      *time.Time `json:"PremiereDate,omitempty"`
+	UserData          *UserData  `json:"UserData,omitempty"`
+}
+
+// UserData is the per-user playback state of an item
+type UserData struct {
+	Played         bool       `json:"Played"`
+	PlayCount      int        `json:"PlayCount"`
+	LastPlayedDate *time.Time `json:"LastPlayedDate,omitempty"`
+}
+
+// Session is an active client session; NowPlayingItem is set during playback
+type Session struct {
+	ID             string `json:"Id"`
+	UserName       string `json:"UserName"`
+	NowPlayingItem *Item  `json:"NowPlayingItem,omitempty"`
+}
+
+type Studio struct {
+	Name string `json:"Name"`
+}
+
+type User struct {
+	ID   string `json:"Id"`
+	Name string `json:"Name"`
 }
 
 type VirtualFolder struct {
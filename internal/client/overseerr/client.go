@@ -78,12 +78,26 @@ func (c *Client) GetTVByTMDB(ctx context.Context, tmdbID int) (*TVDetails, error
 // RequestTV requests specific seasons of a TV show.
 // serverID is optional — when non-nil, it targets a specific Overseerr backend server.
 func (c *Client) RequestTV(ctx context.Context, tmdbID int, seasons []int, serverID *int) (*RequestResponse, error) {
+	return c.RequestTVAs(ctx, 0, tmdbID, seasons, RequestOptions{ServerID: serverID})
+}
+
+// RequestTVAs requests specific seasons of a TV show on behalf of an Overseerr user.
+// userID 0 uses the configured user (overseerr.user_id).
+func (c *Client) RequestTVAs(ctx context.Context, userID, tmdbID int, seasons []int, opts RequestOptions) (*RequestResponse, error) {
+	if userID == 0 {
+		userID = c.userID
+	}
+
 	body := TVRequest{
-		MediaType: string(MediaTypeTV),
-		MediaID:   tmdbID,
-		Seasons:   seasons,
-		UserID:    c.userID,
-		ServerID:  serverID,
+		MediaType:         string(MediaTypeTV),
+		MediaID:           tmdbID,
+		Seasons:           seasons,
+		UserID:            userID,
+		ServerID:          opts.ServerID,
+		ProfileID:         opts.ProfileID,
+		RootFolder:        opts.RootFolder,
+		LanguageProfileID: opts.LanguageProfileID,
+		Tags:              opts.Tags,
 	}
 
 	var result RequestResponse
@@ -101,59 +115,208 @@ func (c *Client) RequestTV(ctx context.Context, tmdbID int, seasons []int, serve
 		return nil, fmt.Errorf("API error: status=%d body=%s", resp.StatusCode(), resp.String())
 	}
 
-	if serverID != nil {
-		logger.Infof("📥 Requested TMDB=%d seasons=%v via Overseerr (serverId=%d)", tmdbID, seasons, *serverID)
+	if opts.ServerID != nil {
+		logger.Infof("📥 Requested TMDB=%d seasons=%v via Overseerr (serverId=%d, userId=%d)", tmdbID, seasons, *opts.ServerID, userID)
 	} else {
-		logger.Infof("📥 Requested TMDB=%d seasons=%v via Overseerr", tmdbID, seasons)
+		logger.Infof("📥 Requested TMDB=%d seasons=%v via Overseerr (userId=%d)", tmdbID, seasons, userID)
 	}
 	return &result, nil
 }
 
-// SeasonRequestInfo contains details about a season's request status
+// GetMovieByTMDB gets movie details by TMDB ID
+func (c *Client) GetMovieByTMDB(ctx context.Context, tmdbID int) (*MovieDetails, error) {
+	var details MovieDetails
+	resp, err := c.client.R().
+		SetContext(ctx).
+		SetResult(&details).
+		Get(fmt.Sprintf("/movie/%d", tmdbID))
+
+	if err != nil {
+		return nil, fmt.Errorf("getting movie details: %w", err)
+	}
+
+	if resp.IsError() {
+		return nil, fmt.Errorf("API error: status=%d", resp.StatusCode())
+	}
+
+	return &details, nil
+}
+
+// RequestMovieAs requests a movie on behalf of an Overseerr user.
+// userID 0 uses the configured user (overseerr.user_id).
+func (c *Client) RequestMovieAs(ctx context.Context, userID, tmdbID int, opts RequestOptions) (*RequestResponse, error) {
+	if userID == 0 {
+		userID = c.userID
+	}
+
+	body := MovieRequest{
+		MediaType:  string(MediaTypeMovie),
+		MediaID:    tmdbID,
+		UserID:     userID,
+		ServerID:   opts.ServerID,
+		ProfileID:  opts.ProfileID,
+		RootFolder: opts.RootFolder,
+		Tags:       opts.Tags,
+	}
+
+	var result RequestResponse
+	resp, err := c.client.R().
+		SetContext(ctx).
+		SetBody(body).
+		SetResult(&result).
+		Post("/request")
+
+	if err != nil {
+		return nil, fmt.Errorf("creating request: %w", err)
+	}
+
+	if resp.IsError() {
+		return nil, fmt.Errorf("API error: status=%d body=%s", resp.StatusCode(), resp.String())
+	}
+
+	if opts.ServerID != nil {
+		logger.Infof("📥 Requested movie TMDB=%d via Overseerr (serverId=%d, userId=%d)", tmdbID, *opts.ServerID, userID)
+	} else {
+		logger.Infof("📥 Requested movie TMDB=%d via Overseerr (userId=%d)", tmdbID, userID)
+	}
+	return &result, nil
+}
+
+// RetryRequest re-submits a failed request to Sonarr/Radarr
+func (c *Client) RetryRequest(ctx context.Context, requestID int) error {
+	resp, err := c.client.R().
+		SetContext(ctx).
+		Post(fmt.Sprintf("/request/%d/retry", requestID))
+
+	if err != nil {
+		return fmt.Errorf("retrying request: %w", err)
+	}
+
+	if resp.IsError() {
+		return fmt.Errorf("API error: status=%d body=%s", resp.StatusCode(), resp.String())
+	}
+
+	logger.Infof("🔁 Retried failed Overseerr request #%d", requestID)
+	return nil
+}
+
+// SeasonRequestInfo contains details about a season's (or movie's) request status
 type SeasonRequestInfo struct {
-	Requested   bool
+	Requested   bool // Pending/approved request, or already processing/available
 	Status      MediaStatus
 	RequestedBy string // Username of who requested it (empty if not requested or available)
+
+	// The request behind the info: the active one when Requested, otherwise
+	// the latest declined or failed one (0 = none)
+	RequestID     int
+	RequestStatus RequestStatus
+	UpdatedAt     time.Time
 }
 
 // GetSeasonRequestInfo returns detailed info about a season's request status
 func (c *Client) GetSeasonRequestInfo(details *TVDetails, seasonNum int) SeasonRequestInfo {
-	info := SeasonRequestInfo{}
-
 	if details.MediaInfo == nil {
-		return info
+		return SeasonRequestInfo{}
 	}
 
-	// Check if season is in any existing request
+	var requests []Request
 	for _, req := range details.MediaInfo.Requests {
 		for _, s := range req.Seasons {
 			if s.SeasonNumber == seasonNum {
-				info.Requested = true
-				if req.RequestedBy != nil {
-					if req.RequestedBy.DisplayName != "" {
-						info.RequestedBy = req.RequestedBy.DisplayName
-					} else {
-						info.RequestedBy = req.RequestedBy.Username
-					}
-				}
-				return info
+				requests = append(requests, req)
+				break
 			}
 		}
 	}
+	info := requestInfo(requests)
+	if info.Requested {
+		return info
+	}
 
 	// Check season availability status
 	for _, s := range details.MediaInfo.Seasons {
 		if s.SeasonNumber == seasonNum {
-			info.Status = s.Status
-			if s.Status >= MediaStatusPending {
-				info.Requested = true
-			}
+			info = withMediaStatus(info, s.Status)
 		}
 	}
 
 	return info
 }
 
+// GetMovieRequestInfo returns a movie's request status, in the same shape as
+// GetSeasonRequestInfo
+func (c *Client) GetMovieRequestInfo(details *MovieDetails) SeasonRequestInfo {
+	if details.MediaInfo == nil {
+		return SeasonRequestInfo{}
+	}
+
+	info := requestInfo(details.MediaInfo.Requests)
+	if info.Requested {
+		return info
+	}
+	return withMediaStatus(info, details.MediaInfo.Status)
+}
+
+// withMediaStatus applies the media status to info that has no active
+// request. A declined or failed request leaves the media pending or
+// processing, so after one only availability counts as requested, and the
+// dead request is dropped so it isn't reported or retried.
+func withMediaStatus(info SeasonRequestInfo, status MediaStatus) SeasonRequestInfo {
+	info.Status = status
+	if info.RequestID == 0 {
+		info.Requested = status >= MediaStatusPending
+		return info
+	}
+	if status >= MediaStatusPartiallyAvail {
+		info.Requested = true
+		info.RequestID = 0
+		info.RequestStatus = 0
+		info.RequestedBy = ""
+		info.UpdatedAt = time.Time{}
+	}
+	return info
+}
+
+// requestInfo summarizes the requests for one season or movie. A pending or
+// approved request wins; otherwise the latest declined or failed one is
+// reported so the caller can decide what to do about it.
+func requestInfo(requests []Request) SeasonRequestInfo {
+	var info SeasonRequestInfo
+	for _, req := range requests {
+		active := req.Status != RequestStatusDeclined && req.Status != RequestStatusFailed
+		if !active && req.ID < info.RequestID {
+			continue
+		}
+
+		info.RequestID = req.ID
+		info.RequestStatus = req.Status
+		info.UpdatedAt = requestTime(req)
+		info.RequestedBy = ""
+		if req.RequestedBy != nil {
+			if req.RequestedBy.DisplayName != "" {
+				info.RequestedBy = req.RequestedBy.DisplayName
+			} else {
+				info.RequestedBy = req.RequestedBy.Username
+			}
+		}
+		if active {
+			info.Requested = true
+			return info
+		}
+	}
+	return info
+}
+
+// requestTime returns when a request last changed
+func requestTime(req Request) time.Time {
+	for _, ts := range []string{req.UpdatedAt, req.CreatedAt} {
+		if t, err := time.Parse(time.RFC3339, ts); err == nil {
+			return t
+		}
+	}
+	return time.Time{}
+}
+
 // IsSeasonRequested checks if a season is already requested or available
 func (c *Client) IsSeasonRequested(details *TVDetails, seasonNum int) bool {
 	return c.GetSeasonRequestInfo(details, seasonNum).Requested
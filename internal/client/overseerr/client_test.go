@@ -0,0 +1,143 @@
+package overseerr
+
+import (
+	"testing"
+	"time"
+)
+
+func TestRequestInfo(t *testing.T) {
+	alice := &User{Username: "alice", DisplayName: "Alice"}
+	bob := &User{Username: "bob"}
+	updated := "2024-03-01T10:00:00Z"
+
+	tests := []struct {
+		name     string
+		requests []Request
+		want     SeasonRequestInfo
+	}{
+		{"no requests", nil, SeasonRequestInfo{}},
+		{
+			name:     "pending",
+			requests: []Request{{ID: 1, Status: RequestStatusPending, RequestedBy: alice}},
+			want:     SeasonRequestInfo{Requested: true, RequestedBy: "Alice", RequestID: 1, RequestStatus: RequestStatusPending},
+		},
+		{
+			name:     "username without display name",
+			requests: []Request{{ID: 1, Status: RequestStatusApproved, RequestedBy: bob}},
+			want:     SeasonRequestInfo{Requested: true, RequestedBy: "bob", RequestID: 1, RequestStatus: RequestStatusApproved},
+		},
+		{
+			name: "active request wins over a later declined one",
+			requests: []Request{
+				{ID: 1, Status: RequestStatusApproved, RequestedBy: alice},
+				{ID: 2, Status: RequestStatusDeclined, RequestedBy: bob},
+			},
+			want: SeasonRequestInfo{Requested: true, RequestedBy: "Alice", RequestID: 1, RequestStatus: RequestStatusApproved},
+		},
+		{
+			name: "active request after a declined one",
+			requests: []Request{
+				{ID: 1, Status: RequestStatusDeclined, RequestedBy: alice},
+				{ID: 2, Status: RequestStatusPending, RequestedBy: bob},
+			},
+			want: SeasonRequestInfo{Requested: true, RequestedBy: "bob", RequestID: 2, RequestStatus: RequestStatusPending},
+		},
+		{
+			name: "latest dead request",
+			requests: []Request{
+				{ID: 5, Status: RequestStatusFailed, RequestedBy: bob, UpdatedAt: updated},
+				{ID: 3, Status: RequestStatusDeclined, RequestedBy: alice},
+			},
+			want: SeasonRequestInfo{
+				RequestedBy:   "bob",
+				RequestID:     5,
+				RequestStatus: RequestStatusFailed,
+				UpdatedAt:     time.Date(2024, 3, 1, 10, 0, 0, 0, time.UTC),
+			},
+		},
+		{
+			name:     "falls back to creation time",
+			requests: []Request{{ID: 1, Status: RequestStatusDeclined, CreatedAt: updated, UpdatedAt: "garbage"}},
+			want: SeasonRequestInfo{
+				RequestID:     1,
+				RequestStatus: RequestStatusDeclined,
+				UpdatedAt:     time.Date(2024, 3, 1, 10, 0, 0, 0, time.UTC),
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got := requestInfo(tt.requests)
+			if got != tt.want {
+				t.Errorf("requestInfo() = %+v, want %+v", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestWithMediaStatus(t *testing.T) {
+	declined := SeasonRequestInfo{
+		RequestedBy:   "Alice",
+		RequestID:     3,
+		RequestStatus: RequestStatusDeclined,
+		UpdatedAt:     time.Date(2024, 3, 1, 10, 0, 0, 0, time.UTC),
+	}
+
+	tests := []struct {
+		name   string
+		info   SeasonRequestInfo
+		status MediaStatus
+		want   SeasonRequestInfo
+	}{
+		{"unknown", SeasonRequestInfo{}, MediaStatusUnknown, SeasonRequestInfo{Status: MediaStatusUnknown}},
+		{"pending", SeasonRequestInfo{}, MediaStatusPending, SeasonRequestInfo{Requested: true, Status: MediaStatusPending}},
+		{"processing", SeasonRequestInfo{}, MediaStatusProcessing, SeasonRequestInfo{Requested: true, Status: MediaStatusProcessing}},
+		{"available", SeasonRequestInfo{}, MediaStatusAvailable, SeasonRequestInfo{Requested: true, Status: MediaStatusAvailable}},
+		{
+			name:   "declined and still pending",
+			info:   declined,
+			status: MediaStatusPending,
+			want: SeasonRequestInfo{
+				Status:        MediaStatusPending,
+				RequestedBy:   declined.RequestedBy,
+				RequestID:     declined.RequestID,
+				RequestStatus: declined.RequestStatus,
+				UpdatedAt:     declined.UpdatedAt,
+			},
+		},
+		{
+			name:   "declined and still processing",
+			info:   declined,
+			status: MediaStatusProcessing,
+			want: SeasonRequestInfo{
+				Status:        MediaStatusProcessing,
+				RequestedBy:   declined.RequestedBy,
+				RequestID:     declined.RequestID,
+				RequestStatus: declined.RequestStatus,
+				UpdatedAt:     declined.UpdatedAt,
+			},
+		},
+		{
+			name:   "declined but partially available",
+			info:   declined,
+			status: MediaStatusPartiallyAvail,
+			want:   SeasonRequestInfo{Requested: true, Status: MediaStatusPartiallyAvail},
+		},
+		{
+			name:   "failed but available",
+			info:   SeasonRequestInfo{RequestID: 4, RequestStatus: RequestStatusFailed, RequestedBy: "bob"},
+			status: MediaStatusAvailable,
+			want:   SeasonRequestInfo{Requested: true, Status: MediaStatusAvailable},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got := withMediaStatus(tt.info, tt.status)
+			if got != tt.want {
+				t.Errorf("withMediaStatus(%v) = %+v, want %+v", tt.status, got, tt.want)
+			}
+		})
+	}
+}
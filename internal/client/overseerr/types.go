@@ -26,8 +26,24 @@ const (
 	RequestStatusPending  RequestStatus = 1
 	RequestStatusApproved RequestStatus = 2
 	RequestStatusDeclined RequestStatus = 3
+	RequestStatusFailed   RequestStatus = 4 // Sonarr/Radarr rejected the approved request
 )
 
+// String returns the status as shown in Overseerr
+func (s RequestStatus) String() string {
+	switch s {
+	case RequestStatusPending:
+		return "pending approval"
+	case RequestStatusApproved:
+		return "approved"
+	case RequestStatusDeclined:
+		return "declined"
+	case RequestStatusFailed:
+		return "failed"
+	}
+	return "unknown"
+}
+
 // SearchResult from Overseerr search API
 type SearchResult struct {
 	Page         int           `json:"page"`
@@ -73,6 +89,7 @@ type Request struct {
 	Seasons     []SeasonReq   `json:"seasons,omitempty"`
 	RequestedBy *User         `json:"requestedBy,omitempty"`
 	CreatedAt   string        `json:"createdAt"`
+	UpdatedAt   string        `json:"updatedAt"`
 }
 
 type SeasonReq struct {
@@ -87,13 +104,38 @@ type User struct {
 	DisplayName string `json:"displayName"`
 }
 
+// RequestOptions picks where Overseerr sends a request. Zero values leave
+// the choice to the server's defaults in Overseerr.
+type RequestOptions struct {
+	ServerID          *int   // Target Overseerr backend server
+	ProfileID         int    // Quality profile
+	RootFolder        string // Root folder path
+	LanguageProfileID int    // Sonarr v3 language profile (TV only)
+	Tags              []int  // Sonarr/Radarr tag IDs
+}
+
 // TVRequest is the payload to request a TV show
 type TVRequest struct {
-	MediaType string `json:"mediaType"`
-	MediaID   int    `json:"mediaId"` // TMDB ID
-	Seasons   []int  `json:"seasons"`
-	UserID    int    `json:"userId,omitempty"`   // Request as specific user
-	ServerID  *int   `json:"serverId,omitempty"` // Target Overseerr backend server
+	MediaType         string `json:"mediaType"`
+	MediaID           int    `json:"mediaId"` // TMDB ID
+	Seasons           []int  `json:"seasons"`
+	UserID            int    `json:"userId,omitempty"`   // Request as specific user
+	ServerID          *int   `json:"serverId,omitempty"` // Target Overseerr backend server
+	ProfileID         int    `json:"profileId,omitempty"`
+	RootFolder        string `json:"rootFolder,omitempty"`
+	LanguageProfileID int    `json:"languageProfileId,omitempty"`
+	Tags              []int  `json:"tags,omitempty"`
+}
+
+// MovieRequest is the payload to request a movie
+type MovieRequest struct {
+	MediaType  string `json:"mediaType"`
+	MediaID    int    `json:"mediaId"`            // TMDB ID
+	UserID     int    `json:"userId,omitempty"`   // Request as specific user
+	ServerID   *int   `json:"serverId,omitempty"` // Target Overseerr backend server
+	ProfileID  int    `json:"profileId,omitempty"`
+	RootFolder string `json:"rootFolder,omitempty"`
+	Tags       []int  `json:"tags,omitempty"`
 }
 
 // TVDetails from Overseerr
@@ -114,6 +156,14 @@ type TVSeason struct {
 	Name         string `json:"name"`
 }
 
+// MovieDetails from Overseerr
+type MovieDetails struct {
+	ID          int        `json:"id"`
+	Title       string     `json:"title"`
+	ReleaseDate string     `json:"releaseDate"`
+	MediaInfo   *MediaInfo `json:"mediaInfo,omitempty"`
+}
+
 // RequestResponse after creating a request
 type RequestResponse struct {
 	ID        int    `json:"id"`
@@ -0,0 +1,131 @@
+package plex
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/go-resty/resty/v2"
+
+	"github.com/fusionn-air/internal/config"
+	"github.com/fusionn-air/pkg/logger"
+)
+
+type Client struct {
+	client *resty.Client
+}
+
+func NewClient(cfg config.PlexConfig) *Client {
+	client := resty.New().
+		SetBaseURL(cfg.BaseURL).
+		SetTimeout(30*time.Second).
+		SetHeader("Accept", "application/json").
+		SetHeader("X-Plex-Token", cfg.Token).
+		SetRetryCount(3).
+		SetRetryWaitTime(1 * time.Second).
+		SetRetryMaxWaitTime(5 * time.Second).
+		AddRetryCondition(func(r *resty.Response, err error) bool {
+			return err != nil || r.StatusCode() >= 500
+		})
+
+	return &Client{client: client}
+}
+
+// GetSections returns all library sections
+func (c *Client) GetSections(ctx context.Context) ([]Section, error) {
+	var resp MediaContainer
+	r, err := c.client.R().
+		SetContext(ctx).
+		SetResult(&resp).
+		Get("/library/sections")
+
+	if err != nil {
+		return nil, fmt.Errorf("getting sections: %w", err)
+	}
+
+	if r.IsError() {
+		return nil, fmt.Errorf("API error: status=%d", r.StatusCode())
+	}
+
+	return resp.MediaContainer.Directory, nil
+}
+
+// GetItems returns all top-level items (shows or movies) in a section, with GUIDs
+func (c *Client) GetItems(ctx context.Context, sectionKey string) ([]Metadata, error) {
+	var resp MediaContainer
+	r, err := c.client.R().
+		SetContext(ctx).
+		SetResult(&resp).
+		SetQueryParam("includeGuids", "1").
+		Get(fmt.Sprintf("/library/sections/%s/all", sectionKey))
+
+	if err != nil {
+		return nil, fmt.Errorf("getting items: %w", err)
+	}
+
+	if r.IsError() {
+		return nil, fmt.Errorf("API error: status=%d", r.StatusCode())
+	}
+
+	return resp.MediaContainer.Metadata, nil
+}
+
+// GetSeasons returns the seasons of a show
+func (c *Client) GetSeasons(ctx context.Context, showKey string) ([]Metadata, error) {
+	return c.getChildren(ctx, showKey, "seasons")
+}
+
+// GetEpisodes returns the episodes of a season
+func (c *Client) GetEpisodes(ctx context.Context, seasonKey string) ([]Metadata, error) {
+	return c.getChildren(ctx, seasonKey, "episodes")
+}
+
+// GetAllEpisodes returns every episode of a show, across seasons, with the
+// token owner's watch state
+func (c *Client) GetAllEpisodes(ctx context.Context, showKey string) ([]Metadata, error) {
+	return c.getMetadata(ctx, fmt.Sprintf("/library/metadata/%s/allLeaves", showKey), "episodes")
+}
+
+func (c *Client) getChildren(ctx context.Context, ratingKey, what string) ([]Metadata, error) {
+	return c.getMetadata(ctx, fmt.Sprintf("/library/metadata/%s/children", ratingKey), what)
+}
+
+func (c *Client) getMetadata(ctx context.Context, path, what string) ([]Metadata, error) {
+	var resp MediaContainer
+	r, err := c.client.R().
+		SetContext(ctx).
+		SetResult(&resp).
+		Get(path)
+
+	if err != nil {
+		return nil, fmt.Errorf("getting %s: %w", what, err)
+	}
+
+	if r.IsError() {
+		return nil, fmt.Errorf("API error: status=%d", r.StatusCode())
+	}
+
+	return resp.MediaContainer.Metadata, nil
+}
+
+// DeleteItem removes an item and its files from Plex.
+// Requires "Allow media deletion" to be enabled on the server.
+func (c *Client) DeleteItem(ctx context.Context, ratingKey string) error {
+	r, err := c.client.R().
+		SetContext(ctx).
+		Delete(fmt.Sprintf("/library/metadata/%s", ratingKey))
+
+	if err != nil {
+		return fmt.Errorf("deleting item: %w", err)
+	}
+
+	if r.IsError() {
+		if r.StatusCode() == 404 {
+			return nil
+		}
+		return fmt.Errorf("API error: status=%d body=%s", r.StatusCode(), r.String())
+	}
+
+	logger.Infof("🗑️  Deleted item ratingKey=%s from Plex", ratingKey)
+	return nil
+}
@@ -0,0 +1,134 @@
+package plex
+
+import (
+	"strconv"
+	"strings"
+)
+
+// MediaContainer wraps every Plex JSON response
+type MediaContainer struct {
+	MediaContainer struct {
+		Size      int        `json:"size"`
+		Directory []Section  `json:"Directory"`
+		Metadata  []Metadata `json:"Metadata"`
+	} `json:"MediaContainer"`
+}
+
+// Section is a Plex library section
+type Section struct {
+	Key   string `json:"key"`
+	Title string `json:"title"`
+	Type  string `json:"type"` // "movie", "show", "artist", "photo"
+}
+
+// Metadata is a Plex library item (show, season, episode or movie)
+type Metadata struct {
+	RatingKey             string  `json:"ratingKey"`
+	Key                   string  `json:"key"`
+	Type                  string  `json:"type"`
+	Title                 string  `json:"title"`
+	Year                  int     `json:"year"`
+	Index                 int     `json:"index"`                 // Season or episode number
+	ParentIndex           int     `json:"parentIndex"`           // Season number of an episode
+	OriginallyAvailableAt string  `json:"originallyAvailableAt"` // "2008-01-20"
+	LeafCount             int     `json:"leafCount"`             // Episodes (shows/seasons)
+	ViewedLeafCount       int     `json:"viewedLeafCount"`       // Watched episodes (shows/seasons)
+	ViewCount             int     `json:"viewCount"`             // Token owner's plays
+	LastViewedAt          int64   `json:"lastViewedAt"`          // Unix seconds
+	GUID                  string  `json:"guid"`                  // Agent GUID, e.g. "com.plexapp.agents.thetvdb://81189?lang=en"
+	GUIDs                 []GUID  `json:"Guid"`
+	Studio                string  `json:"studio"` // Network for shows
+	Genres                []Tag   `json:"Genre"`
+	Media                 []Media `json:"Media"`
+}
+
+// Media is one version of an item; episodes without files have none
+type Media struct {
+	ID   int    `json:"id"`
+	Part []Part `json:"Part"`
+}
+
+// Part is a file of a media version
+type Part struct {
+	File string `json:"file"`
+}
+
+// Tag is a Plex tag such as a genre
+type Tag struct {
+	Tag string `json:"tag"`
+}
+
+// File returns the path of the item's first media file, if any
+func (m Metadata) File() string {
+	for _, media := range m.Media {
+		for _, part := range media.Part {
+			if part.File != "" {
+				return part.File
+			}
+		}
+	}
+	return ""
+}
+
+// GUID is an external ID reference like "tvdb://12345" or "tmdb://678"
+type GUID struct {
+	ID string `json:"id"`
+}
+
+// ExternalGUIDs returns the item's external ID references. Items matched by a
+// legacy agent have no Guid list, only the agent GUID.
+func (m Metadata) ExternalGUIDs() []GUID {
+	if m.GUID == "" {
+		return m.GUIDs
+	}
+	return append(append([]GUID(nil), m.GUIDs...), GUID{ID: m.GUID})
+}
+
+// legacyAgents maps a scheme to the GUID prefixes of legacy Plex agents
+// carrying that ID, e.g. "com.plexapp.agents.thetvdb://81189?lang=en"
+var legacyAgents = map[string][]string{
+	"tvdb": {"com.plexapp.agents.thetvdb://", "com.plexapp.agents.hama://tvdb-"},
+	"tmdb": {"com.plexapp.agents.themoviedb://"},
+	"imdb": {"com.plexapp.agents.imdb://"},
+}
+
+// Section types
+const (
+	SectionTypeMovie = "movie"
+	SectionTypeShow  = "show"
+)
+
+// ParseGUID extracts a numeric external ID (e.g. "tvdb", "tmdb") from an item's GUIDs.
+// New-agent GUIDs require items to be fetched with includeGuids=1.
+func ParseGUID(guids []GUID, scheme string) int {
+	id, err := strconv.Atoi(GUIDValue(guids, scheme))
+	if err != nil {
+		return 0
+	}
+	return id
+}
+
+// GUIDValue returns the raw external ID for a scheme (e.g. "imdb" → "tt0133093"),
+// from either a new-agent GUID ("imdb://tt0133093") or a legacy agent GUID
+// ("com.plexapp.agents.imdb://tt0133093?lang=en")
+func GUIDValue(guids []GUID, scheme string) string {
+	prefix := scheme + "://"
+	for _, g := range guids {
+		if strings.HasPrefix(g.ID, prefix) {
+			return strings.TrimPrefix(g.ID, prefix)
+		}
+	}
+
+	for _, g := range guids {
+		for _, agent := range legacyAgents[scheme] {
+			if value, ok := strings.CutPrefix(g.ID, agent); ok {
+				// Drop the query ("?lang=en") and any season/episode path
+				if i := strings.IndexAny(value, "?/"); i >= 0 {
+					value = value[:i]
+				}
+				return value
+			}
+		}
+	}
+	return ""
+}
@@ -88,6 +88,27 @@ func (c *Client) GetMovieByTmdbID(ctx context.Context, tmdbID int) (*Movie, erro
 	return nil, nil // Not found
 }
 
+// AddMovie adds a movie to Radarr
+func (c *Client) AddMovie(ctx context.Context, movie NewMovie) (*Movie, error) {
+	var added Movie
+	resp, err := c.client.R().
+		SetContext(ctx).
+		SetBody(movie).
+		SetResult(&added).
+		Post("/movie")
+
+	if err != nil {
+		return nil, fmt.Errorf("adding movie: %w", err)
+	}
+
+	if resp.IsError() {
+		return nil, fmt.Errorf("API error: status=%d body=%s", resp.StatusCode(), resp.String())
+	}
+
+	logger.Infof("➕ Added movie to Radarr: %s (ID=%d)", added.Title, added.ID)
+	return &added, nil
+}
+
 // DeleteMovie removes a movie from Radarr
 func (c *Client) DeleteMovie(ctx context.Context, movieID int, deleteFiles bool) error {
 	resp, err := c.client.R().
@@ -111,6 +132,63 @@ func (c *Client) DeleteMovie(ctx context.Context, movieID int, deleteFiles bool)
 	return nil
 }
 
+// GetTags returns all tags
+func (c *Client) GetTags(ctx context.Context) ([]Tag, error) {
+	var tags []Tag
+	resp, err := c.client.R().
+		SetContext(ctx).
+		SetResult(&tags).
+		Get("/tag")
+
+	if err != nil {
+		return nil, fmt.Errorf("getting tags: %w", err)
+	}
+
+	if resp.IsError() {
+		return nil, fmt.Errorf("API error: status=%d", resp.StatusCode())
+	}
+
+	return tags, nil
+}
+
+// GetQualityProfiles returns all quality profiles
+func (c *Client) GetQualityProfiles(ctx context.Context) ([]QualityProfile, error) {
+	var profiles []QualityProfile
+	resp, err := c.client.R().
+		SetContext(ctx).
+		SetResult(&profiles).
+		Get("/qualityprofile")
+
+	if err != nil {
+		return nil, fmt.Errorf("getting quality profiles: %w", err)
+	}
+
+	if resp.IsError() {
+		return nil, fmt.Errorf("API error: status=%d", resp.StatusCode())
+	}
+
+	return profiles, nil
+}
+
+// GetRootFolders returns the configured root folders with their free space
+func (c *Client) GetRootFolders(ctx context.Context) ([]RootFolder, error) {
+	var folders []RootFolder
+	resp, err := c.client.R().
+		SetContext(ctx).
+		SetResult(&folders).
+		Get("/rootfolder")
+
+	if err != nil {
+		return nil, fmt.Errorf("getting root folders: %w", err)
+	}
+
+	if resp.IsError() {
+		return nil, fmt.Errorf("API error: status=%d", resp.StatusCode())
+	}
+
+	return folders, nil
+}
+
 // UnmonitorMovie sets a movie to unmonitored in Radarr
 func (c *Client) UnmonitorMovie(ctx context.Context, movieID int) error {
 	// Get current movie data
@@ -146,6 +224,39 @@ func (c *Client) UnmonitorMovie(ctx context.Context, movieID int) error {
 	return nil
 }
 
+// MonitorMovie sets a movie to monitored in Radarr
+func (c *Client) MonitorMovie(ctx context.Context, movieID int) error {
+	movie, err := c.GetMovie(ctx, movieID)
+	if err != nil {
+		return fmt.Errorf("getting movie for monitor: %w", err)
+	}
+	if movie == nil {
+		return nil // Already gone
+	}
+
+	if movie.Monitored {
+		return nil // Already monitored
+	}
+
+	movie.Monitored = true
+
+	resp, err := c.client.R().
+		SetContext(ctx).
+		SetBody(movie).
+		Put(fmt.Sprintf("/movie/%d", movieID))
+
+	if err != nil {
+		return fmt.Errorf("monitoring movie: %w", err)
+	}
+
+	if resp.IsError() {
+		return fmt.Errorf("API error: status=%d body=%s", resp.StatusCode(), resp.String())
+	}
+
+	logger.Infof("🔔 Monitored movie ID=%d (%s)", movieID, movie.Title)
+	return nil
+}
+
 // FormatSize formats bytes to human readable string
 func FormatSize(bytes int64) string {
 	const unit = 1024
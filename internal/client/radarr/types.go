@@ -32,6 +32,8 @@ type Movie struct {
 	DigitalRelease   string     `json:"digitalRelease,omitempty"`
 	PhysicalRelease  string     `json:"physicalRelease,omitempty"`
 	InCinemas        string     `json:"inCinemas,omitempty"`
+
+	MinimumAvailability string `json:"minimumAvailability,omitempty"`
 }
 
 type Rating struct {
@@ -71,6 +73,25 @@ type MediaInfo struct {
 	Subtitles      string  `json:"subtitles"`
 }
 
+// NewMovie is the body for adding a movie to Radarr
+type NewMovie struct {
+	Title               string          `json:"title"`
+	TmdbID              int             `json:"tmdbId"`
+	Year                int             `json:"year,omitempty"`
+	QualityProfileID    int             `json:"qualityProfileId"`
+	RootFolderPath      string          `json:"rootFolderPath"`
+	Path                string          `json:"path,omitempty"`
+	MinimumAvailability string          `json:"minimumAvailability,omitempty"`
+	Monitored           bool            `json:"monitored"`
+	Tags                []int           `json:"tags"`
+	AddOptions          AddMovieOptions `json:"addOptions"`
+}
+
+// AddMovieOptions controls what Radarr does once a movie is added
+type AddMovieOptions struct {
+	SearchForMovie bool `json:"searchForMovie"`
+}
+
 // DeleteOptions for removing a movie
 type DeleteOptions struct {
 	DeleteFiles        bool `json:"deleteFiles"`
@@ -83,3 +104,23 @@ const (
 	StatusAnnounced = "announced"
 	StatusInCinemas = "inCinemas"
 )
+
+// RootFolder is a library root folder and the free space on its disk
+type RootFolder struct {
+	ID         int    `json:"id"`
+	Path       string `json:"path"`
+	Accessible bool   `json:"accessible"`
+	FreeSpace  int64  `json:"freeSpace"`
+}
+
+// Tag is a label that can be attached to items
+type Tag struct {
+	ID    int    `json:"id"`
+	Label string `json:"label"`
+}
+
+// QualityProfile is a named quality profile
+type QualityProfile struct {
+	ID   int    `json:"id"`
+	Name string `json:"name"`
+}
@@ -111,6 +111,202 @@ func (c *Client) DeleteSeries(ctx context.Context, seriesID int, deleteFiles boo
 	return nil
 }
 
+// AddSeries adds a series to Sonarr
+func (c *Client) AddSeries(ctx context.Context, series NewSeries) (*Series, error) {
+	var added Series
+	resp, err := c.client.R().
+		SetContext(ctx).
+		SetBody(series).
+		SetResult(&added).
+		Post("/series")
+
+	if err != nil {
+		return nil, fmt.Errorf("adding series: %w", err)
+	}
+
+	if resp.IsError() {
+		return nil, fmt.Errorf("API error: status=%d body=%s", resp.StatusCode(), resp.String())
+	}
+
+	logger.Infof("➕ Added series to Sonarr: %s (ID=%d)", added.Title, added.ID)
+	return &added, nil
+}
+
+// GetEpisodeFiles returns all episode files for a series
+func (c *Client) GetEpisodeFiles(ctx context.Context, seriesID int) ([]EpisodeFile, error) {
+	var files []EpisodeFile
+	resp, err := c.client.R().
+		SetContext(ctx).
+		SetQueryParam("seriesId", fmt.Sprintf("%d", seriesID)).
+		SetResult(&files).
+		Get("/episodefile")
+
+	if err != nil {
+		return nil, fmt.Errorf("getting episode files: %w", err)
+	}
+
+	if resp.IsError() {
+		return nil, fmt.Errorf("API error: status=%d", resp.StatusCode())
+	}
+
+	return files, nil
+}
+
+// DeleteEpisodeFiles deletes episode files from disk
+func (c *Client) DeleteEpisodeFiles(ctx context.Context, fileIDs []int) error {
+	if len(fileIDs) == 0 {
+		return nil
+	}
+
+	resp, err := c.client.R().
+		SetContext(ctx).
+		SetBody(map[string][]int{"episodeFileIds": fileIDs}).
+		Delete("/episodefile/bulk")
+
+	if err != nil {
+		return fmt.Errorf("deleting episode files: %w", err)
+	}
+
+	if resp.IsError() {
+		if resp.StatusCode() == 404 {
+			return nil // Already deleted
+		}
+		return fmt.Errorf("API error: status=%d body=%s", resp.StatusCode(), resp.String())
+	}
+
+	logger.Infof("🗑️  Deleted %d episode files from Sonarr", len(fileIDs))
+	return nil
+}
+
+// UnmonitorSeason sets a season and its episodes to unmonitored in Sonarr
+func (c *Client) UnmonitorSeason(ctx context.Context, seriesID, seasonNumber int) error {
+	series, err := c.GetSeries(ctx, seriesID)
+	if err != nil {
+		return fmt.Errorf("getting series for unmonitor: %w", err)
+	}
+	if series == nil {
+		return nil // Already gone
+	}
+
+	for i := range series.Seasons {
+		if series.Seasons[i].SeasonNumber == seasonNumber {
+			series.Seasons[i].Monitored = false
+		}
+	}
+
+	resp, err := c.client.R().
+		SetContext(ctx).
+		SetBody(series).
+		Put(fmt.Sprintf("/series/%d", seriesID))
+
+	if err != nil {
+		return fmt.Errorf("unmonitoring season: %w", err)
+	}
+
+	if resp.IsError() {
+		return fmt.Errorf("API error: status=%d body=%s", resp.StatusCode(), resp.String())
+	}
+
+	// Season monitoring doesn't cascade to existing episodes
+	episodes, err := c.GetEpisodes(ctx, seriesID)
+	if err != nil {
+		return err
+	}
+
+	var episodeIDs []int
+	for _, ep := range episodes {
+		if ep.SeasonNumber == seasonNumber && ep.Monitored {
+			episodeIDs = append(episodeIDs, ep.ID)
+		}
+	}
+
+	if err := c.UnmonitorEpisodes(ctx, episodeIDs); err != nil {
+		return err
+	}
+
+	logger.Infof("🔕 Unmonitored %s season %d", series.Title, seasonNumber)
+	return nil
+}
+
+// UnmonitorEpisodes sets episodes to unmonitored in Sonarr
+func (c *Client) UnmonitorEpisodes(ctx context.Context, episodeIDs []int) error {
+	if len(episodeIDs) == 0 {
+		return nil
+	}
+
+	resp, err := c.client.R().
+		SetContext(ctx).
+		SetBody(map[string]any{"episodeIds": episodeIDs, "monitored": false}).
+		Put("/episode/monitor")
+
+	if err != nil {
+		return fmt.Errorf("unmonitoring episodes: %w", err)
+	}
+
+	if resp.IsError() {
+		return fmt.Errorf("API error: status=%d body=%s", resp.StatusCode(), resp.String())
+	}
+
+	return nil
+}
+
+// GetTags returns all tags
+func (c *Client) GetTags(ctx context.Context) ([]Tag, error) {
+	var tags []Tag
+	resp, err := c.client.R().
+		SetContext(ctx).
+		SetResult(&tags).
+		Get("/tag")
+
+	if err != nil {
+		return nil, fmt.Errorf("getting tags: %w", err)
+	}
+
+	if resp.IsError() {
+		return nil, fmt.Errorf("API error: status=%d", resp.StatusCode())
+	}
+
+	return tags, nil
+}
+
+// GetQualityProfiles returns all quality profiles
+func (c *Client) GetQualityProfiles(ctx context.Context) ([]QualityProfile, error) {
+	var profiles []QualityProfile
+	resp, err := c.client.R().
+		SetContext(ctx).
+		SetResult(&profiles).
+		Get("/qualityprofile")
+
+	if err != nil {
+		return nil, fmt.Errorf("getting quality profiles: %w", err)
+	}
+
+	if resp.IsError() {
+		return nil, fmt.Errorf("API error: status=%d", resp.StatusCode())
+	}
+
+	return profiles, nil
+}
+
+// GetRootFolders returns the configured root folders with their free space
+func (c *Client) GetRootFolders(ctx context.Context) ([]RootFolder, error) {
+	var folders []RootFolder
+	resp, err := c.client.R().
+		SetContext(ctx).
+		SetResult(&folders).
+		Get("/rootfolder")
+
+	if err != nil {
+		return nil, fmt.Errorf("getting root folders: %w", err)
+	}
+
+	if resp.IsError() {
+		return nil, fmt.Errorf("API error: status=%d", resp.StatusCode())
+	}
+
+	return folders, nil
+}
+
 // GetEpisodes returns all episodes for a series
 func (c *Client) GetEpisodes(ctx context.Context, seriesID int) ([]Episode, error) {
 	var episodes []Episode
@@ -166,6 +362,41 @@ func (c *Client) UnmonitorSeries(ctx context.Context, seriesID int) error {
 	return nil
 }
 
+// SetSeriesMonitoring sets series and per-season monitoring in Sonarr.
+// Seasons missing from the map keep their current state.
+func (c *Client) SetSeriesMonitoring(ctx context.Context, seriesID int, monitored bool, seasons map[int]bool) error {
+	series, err := c.GetSeries(ctx, seriesID)
+	if err != nil {
+		return fmt.Errorf("getting series for monitor: %w", err)
+	}
+	if series == nil {
+		return nil // Already gone
+	}
+
+	series.Monitored = monitored
+	for i := range series.Seasons {
+		if m, ok := seasons[series.Seasons[i].SeasonNumber]; ok {
+			series.Seasons[i].Monitored = m
+		}
+	}
+
+	resp, err := c.client.R().
+		SetContext(ctx).
+		SetBody(series).
+		Put(fmt.Sprintf("/series/%d", seriesID))
+
+	if err != nil {
+		return fmt.Errorf("monitoring series: %w", err)
+	}
+
+	if resp.IsError() {
+		return fmt.Errorf("API error: status=%d body=%s", resp.StatusCode(), resp.String())
+	}
+
+	logger.Infof("🔔 Restored monitoring for series ID=%d (%s)", seriesID, series.Title)
+	return nil
+}
+
 // IsSeriesEnded checks if a series has ended (not continuing)
 func IsSeriesEnded(series *Series) bool {
 	return series.Status == StatusEnded
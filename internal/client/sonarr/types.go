@@ -56,11 +56,13 @@ type Season struct {
 }
 
 type SeasonStatistics struct {
-	EpisodeFileCount  int     `json:"episodeFileCount"`
-	EpisodeCount      int     `json:"episodeCount"`
-	TotalEpisodeCount int     `json:"totalEpisodeCount"`
-	SizeOnDisk        int64   `json:"sizeOnDisk"`
-	PercentOfEpisodes float64 `json:"percentOfEpisodes"`
+	EpisodeFileCount  int        `json:"episodeFileCount"`
+	EpisodeCount      int        `json:"episodeCount"`
+	TotalEpisodeCount int        `json:"totalEpisodeCount"`
+	SizeOnDisk        int64      `json:"sizeOnDisk"`
+	PercentOfEpisodes float64    `json:"percentOfEpisodes"`
+	PreviousAiring    *time.Time `json:"previousAiring,omitempty"`
+	NextAiring        *time.Time `json:"nextAiring,omitempty"`
 }
 
 // Episode represents an episode in Sonarr
@@ -81,6 +83,37 @@ type Episode struct {
 	UnverifiedSceneNumbering bool      `json:"unverifiedSceneNumbering"`
 }
 
+// EpisodeFile represents an episode file on disk
+type EpisodeFile struct {
+	ID           int    `json:"id"`
+	SeriesID     int    `json:"seriesId"`
+	SeasonNumber int    `json:"seasonNumber"`
+	RelativePath string `json:"relativePath"`
+	Path         string `json:"path"`
+	Size         int64  `json:"size"`
+}
+
+// NewSeries is the body for adding a series to Sonarr
+type NewSeries struct {
+	Title             string           `json:"title"`
+	TvdbID            int              `json:"tvdbId"`
+	QualityProfileID  int              `json:"qualityProfileId"`
+	LanguageProfileID int              `json:"languageProfileId,omitempty"`
+	RootFolderPath    string           `json:"rootFolderPath"`
+	Path              string           `json:"path,omitempty"`
+	SeasonFolder      bool             `json:"seasonFolder"`
+	SeriesType        string           `json:"seriesType,omitempty"`
+	Monitored         bool             `json:"monitored"`
+	Seasons           []Season         `json:"seasons,omitempty"`
+	Tags              []int            `json:"tags"`
+	AddOptions        AddSeriesOptions `json:"addOptions"`
+}
+
+// AddSeriesOptions controls what Sonarr does once a series is added
+type AddSeriesOptions struct {
+	SearchForMissingEpisodes bool `json:"searchForMissingEpisodes"`
+}
+
 // DeleteOptions for removing a series
 type DeleteOptions struct {
 	DeleteFiles            bool `json:"deleteFiles"`
@@ -93,3 +126,30 @@ const (
 	StatusEnded      = "ended"
 	StatusUpcoming   = "upcoming"
 )
+
+// SeriesType constants
+const (
+	SeriesTypeStandard = "standard"
+	SeriesTypeDaily    = "daily"
+	SeriesTypeAnime    = "anime"
+)
+
+// RootFolder is a library root folder and the free space on its disk
+type RootFolder struct {
+	ID         int    `json:"id"`
+	Path       string `json:"path"`
+	Accessible bool   `json:"accessible"`
+	FreeSpace  int64  `json:"freeSpace"`
+}
+
+// Tag is a label that can be attached to items
+type Tag struct {
+	ID    int    `json:"id"`
+	Label string `json:"label"`
+}
+
+// QualityProfile is a named quality profile
+type QualityProfile struct {
+	ID   int    `json:"id"`
+	Name string `json:"name"`
+}
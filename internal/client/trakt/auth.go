@@ -16,10 +16,20 @@ import (
 )
 
 const (
-	tokenFile       = "data/trakt_tokens.json"
-	tokenExpirySafe = 24 * time.Hour // Refresh 1 day before expiry
+	defaultTokenFile = "data/trakt_tokens.json"
+	tokenExpirySafe  = 24 * time.Hour // Refresh 1 day before expiry
 )
 
+// TokenFileFor returns the token file path for a named account.
+// The primary account (empty name) keeps the original path; additional
+// account names are checked to be non-empty and path-safe at config load.
+func TokenFileFor(name string) string {
+	if name == "" {
+		return defaultTokenFile
+	}
+	return fmt.Sprintf("data/trakt_tokens_%s.json", name)
+}
+
 // TokenStore holds OAuth tokens
 type TokenStore struct {
 	AccessToken  string    `json:"access_token"`
@@ -53,14 +63,21 @@ type AuthManager struct {
 	clientID     string
 	clientSecret string
 	baseURL      string
+	account      string // Account name shown in the device auth prompt ("" = primary)
+	tokenFile    string
 
 	mu         sync.RWMutex
 	tokens     *TokenStore
 	retryAfter time.Time // Rate limit retry-after for OAuth endpoints
 }
 
-// NewAuthManager creates a new auth manager
+// NewAuthManager creates a new auth manager for the primary account
 func NewAuthManager(clientID, clientSecret, baseURL string) *AuthManager {
+	return NewAccountAuthManager(clientID, clientSecret, baseURL, "", defaultTokenFile)
+}
+
+// NewAccountAuthManager creates an auth manager for a named account with its own token file
+func NewAccountAuthManager(clientID, clientSecret, baseURL, account, tokenFile string) *AuthManager {
 	return &AuthManager{
 		client: resty.New().
 			SetTimeout(30*time.Second).
@@ -70,6 +87,8 @@ func NewAuthManager(clientID, clientSecret, baseURL string) *AuthManager {
 		clientID:     clientID,
 		clientSecret: clientSecret,
 		baseURL:      baseURL,
+		account:      account,
+		tokenFile:    tokenFile,
 	}
 }
 
@@ -125,7 +144,7 @@ func (a *AuthManager) needsRefresh() bool {
 
 // loadTokens loads tokens from file
 func (a *AuthManager) loadTokens() error {
-	data, err := os.ReadFile(tokenFile)
+	data, err := os.ReadFile(a.tokenFile)
 	if err != nil {
 		return err
 	}
@@ -152,7 +171,7 @@ func (a *AuthManager) saveTokens() error {
 		return fmt.Errorf("no tokens to save")
 	}
 
-	dir := filepath.Dir(tokenFile)
+	dir := filepath.Dir(a.tokenFile)
 	if err := os.MkdirAll(dir, 0o755); err != nil {
 		return fmt.Errorf("creating data dir: %w", err)
 	}
@@ -162,7 +181,7 @@ func (a *AuthManager) saveTokens() error {
 		return err
 	}
 
-	return os.WriteFile(tokenFile, data, 0o600)
+	return os.WriteFile(a.tokenFile, data, 0o600)
 }
 
 // startDeviceAuth initiates the device authorization flow
@@ -188,6 +207,9 @@ func (a *AuthManager) startDeviceAuth(ctx context.Context) error {
 	logger.Info("┌──────────────────────────────────────────────────────────────┐")
 	logger.Info("│               TRAKT AUTHORIZATION REQUIRED                   │")
 	logger.Info("├──────────────────────────────────────────────────────────────┤")
+	if a.account != "" {
+		logger.Infof("│  Account: %-51s│", a.account)
+	}
 	logger.Infof("│  1. Go to: %-50s│", deviceCode.VerificationURL)
 	logger.Infof("│  2. Enter code: %-45s│", deviceCode.UserCode)
 	logger.Info("│  3. Click 'Authorize' on the Trakt website                   │")
@@ -28,6 +28,7 @@ type Client struct {
 	client   *resty.Client
 	auth     *AuthManager
 	clientID string
+	name     string // Account name ("" = primary)
 
 	// Rate limiter
 	getLimiter *rate.Limiter
@@ -38,9 +39,24 @@ type Client struct {
 	lastRequest time.Time
 }
 
+// NewClient creates a client for the primary Trakt account
 func NewClient(cfg config.TraktConfig) *Client {
+	return newClient(cfg, "", TokenFileFor(""))
+}
+
+// NewUserClient creates a client for an additional Trakt account with its own tokens
+func NewUserClient(cfg config.TraktConfig, user config.TraktUserConfig) *Client {
+	tokenFile := user.TokenFile
+	if tokenFile == "" {
+		tokenFile = TokenFileFor(user.Name)
+	}
+	return newClient(cfg, user.Name, tokenFile)
+}
+
+func newClient(cfg config.TraktConfig, name, tokenFile string) *Client {
 	c := &Client{
 		clientID:   cfg.ClientID,
+		name:       name,
 		getLimiter: rate.NewLimiter(rate.Limit(defaultGetRate), burstSize),
 	}
 
@@ -64,11 +80,16 @@ func NewClient(cfg config.TraktConfig) *Client {
 		})
 
 	c.client = client
-	c.auth = NewAuthManager(cfg.ClientID, cfg.ClientSecret, cfg.BaseURL)
+	c.auth = NewAccountAuthManager(cfg.ClientID, cfg.ClientSecret, cfg.BaseURL, name, tokenFile)
 
 	return c
 }
 
+// Name returns the account name ("" for the primary account)
+func (c *Client) Name() string {
+	return c.name
+}
+
 // handleRateLimitHeaders processes rate limit info from response
 func (c *Client) handleRateLimitHeaders(resp *resty.Response) {
 	c.mu.Lock()
@@ -265,6 +286,35 @@ func (c *Client) GetLastCompletedSeason(progress *ShowProgress) int {
 	return lastCompleted
 }
 
+// GetShow returns a show's details, including genres and country
+func (c *Client) GetShow(ctx context.Context, showID int) (*Show, error) {
+	if err := c.ensureAuth(ctx); err != nil {
+		return nil, fmt.Errorf("auth: %w", err)
+	}
+
+	if err := c.waitForRate(ctx, false); err != nil {
+		return nil, fmt.Errorf("rate limit: %w", err)
+	}
+
+	path := fmt.Sprintf("/shows/%d?extended=full", showID)
+
+	var show Show
+	resp, err := c.client.R().
+		SetContext(ctx).
+		SetResult(&show).
+		Get(path)
+
+	if err != nil {
+		return nil, fmt.Errorf("getting show: %w", err)
+	}
+
+	if resp.IsError() {
+		return nil, fmt.Errorf("API error: status=%d", resp.StatusCode())
+	}
+
+	return &show, nil
+}
+
 // GetShowSeasons returns season summaries including total episode counts
 func (c *Client) GetShowSeasons(ctx context.Context, showID int) ([]SeasonSummary, error) {
 	if err := c.ensureAuth(ctx); err != nil {
@@ -321,3 +371,117 @@ func (c *Client) GetWatchedMovies(ctx context.Context) ([]WatchedMovie, error) {
 	logger.Debugf("Fetched %d watched movies from Trakt", len(movies))
 	return movies, nil
 }
+
+// GetWatchlist returns the user's watchlist (movies, shows, seasons and episodes)
+func (c *Client) GetWatchlist(ctx context.Context) ([]ListItem, error) {
+	return c.getListItems(ctx, "/users/me/watchlist")
+}
+
+// GetMovieWatchlist returns the movies on the user's watchlist, with genres and country
+func (c *Client) GetMovieWatchlist(ctx context.Context) ([]ListItem, error) {
+	return c.getListItems(ctx, "/users/me/watchlist/movies?extended=full")
+}
+
+// GetLists returns the user's personal lists
+func (c *Client) GetLists(ctx context.Context) ([]List, error) {
+	if err := c.ensureAuth(ctx); err != nil {
+		return nil, fmt.Errorf("auth: %w", err)
+	}
+
+	if err := c.waitForRate(ctx, false); err != nil {
+		return nil, fmt.Errorf("rate limit: %w", err)
+	}
+
+	var lists []List
+	resp, err := c.client.R().
+		SetContext(ctx).
+		SetResult(&lists).
+		Get("/users/me/lists")
+
+	if err != nil {
+		return nil, fmt.Errorf("getting lists: %w", err)
+	}
+
+	if resp.IsError() {
+		return nil, fmt.Errorf("API error: status=%d", resp.StatusCode())
+	}
+
+	return lists, nil
+}
+
+// GetListItems returns the items of one of the user's lists (by slug or Trakt ID)
+func (c *Client) GetListItems(ctx context.Context, list string) ([]ListItem, error) {
+	return c.getListItems(ctx, fmt.Sprintf("/users/me/lists/%s/items", list))
+}
+
+func (c *Client) getListItems(ctx context.Context, path string) ([]ListItem, error) {
+	if err := c.ensureAuth(ctx); err != nil {
+		return nil, fmt.Errorf("auth: %w", err)
+	}
+
+	if err := c.waitForRate(ctx, false); err != nil {
+		return nil, fmt.Errorf("rate limit: %w", err)
+	}
+
+	var items []ListItem
+	resp, err := c.client.R().
+		SetContext(ctx).
+		SetResult(&items).
+		Get(path)
+
+	if err != nil {
+		return nil, fmt.Errorf("getting list items: %w", err)
+	}
+
+	if resp.IsError() {
+		return nil, fmt.Errorf("API error: status=%d", resp.StatusCode())
+	}
+
+	logger.Debugf("Fetched %d items from Trakt %s", len(items), path)
+	return items, nil
+}
+
+// GetAnticipatedMovies returns the most anticipated upcoming movies
+func (c *Client) GetAnticipatedMovies(ctx context.Context, limit int) ([]AnticipatedMovie, error) {
+	var movies []AnticipatedMovie
+	if err := c.getMovieChart(ctx, "/movies/anticipated", limit, &movies); err != nil {
+		return nil, err
+	}
+	return movies, nil
+}
+
+// GetTrendingMovies returns the movies being watched the most right now
+func (c *Client) GetTrendingMovies(ctx context.Context, limit int) ([]TrendingMovie, error) {
+	var movies []TrendingMovie
+	if err := c.getMovieChart(ctx, "/movies/trending", limit, &movies); err != nil {
+		return nil, err
+	}
+	return movies, nil
+}
+
+func (c *Client) getMovieChart(ctx context.Context, path string, limit int, result any) error {
+	if err := c.ensureAuth(ctx); err != nil {
+		return fmt.Errorf("auth: %w", err)
+	}
+
+	if err := c.waitForRate(ctx, false); err != nil {
+		return fmt.Errorf("rate limit: %w", err)
+	}
+
+	resp, err := c.client.R().
+		SetContext(ctx).
+		SetQueryParam("extended", "full").
+		SetQueryParam("limit", strconv.Itoa(limit)).
+		SetResult(result).
+		Get(path)
+
+	if err != nil {
+		return fmt.Errorf("getting %s: %w", path, err)
+	}
+
+	if resp.IsError() {
+		return fmt.Errorf("API error: status=%d", resp.StatusCode())
+	}
+
+	return nil
+}
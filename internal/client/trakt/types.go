@@ -18,11 +18,14 @@ type Episode struct {
 }
 
 type Show struct {
-	Title   string   `json:"title"`
-	Year    int      `json:"year"`
-	IDs     IDs      `json:"ids"`
-	Genres  []string `json:"genres,omitempty"`
-	Country string   `json:"country,omitempty"`
+	Title         string   `json:"title"`
+	Year          int      `json:"year"`
+	IDs           IDs      `json:"ids"`
+	Genres        []string `json:"genres,omitempty"`
+	Country       string   `json:"country,omitempty"`
+	Network       string   `json:"network,omitempty"`
+	Language      string   `json:"language,omitempty"`
+	Certification string   `json:"certification,omitempty"`
 }
 
 type IDs struct {
@@ -87,19 +90,36 @@ type WatchedEpisode struct {
 
 // SeasonSummary from /shows/{id}/seasons
 type SeasonSummary struct {
-	Number        int    `json:"number"`
-	IDs           IDs    `json:"ids"`
-	EpisodeCount  int    `json:"episode_count"`  // Total episodes in season
-	AiredEpisodes int    `json:"aired_episodes"` // Episodes that have aired
-	Title         string `json:"title"`
-	Overview      string `json:"overview"`
+	Number        int        `json:"number"`
+	IDs           IDs        `json:"ids"`
+	EpisodeCount  int        `json:"episode_count"`  // Total episodes in season
+	AiredEpisodes int        `json:"aired_episodes"` // Episodes that have aired
+	Title         string     `json:"title"`
+	Overview      string     `json:"overview"`
+	FirstAired    *time.Time `json:"first_aired"` // Season premiere (nil if not yet scheduled)
 }
 
 // Movie represents a movie from Trakt
 type Movie struct {
-	Title string `json:"title"`
-	Year  int    `json:"year"`
-	IDs   IDs    `json:"ids"`
+	Title         string   `json:"title"`
+	Year          int      `json:"year"`
+	IDs           IDs      `json:"ids"`
+	Genres        []string `json:"genres,omitempty"`
+	Country       string   `json:"country,omitempty"`
+	Language      string   `json:"language,omitempty"`
+	Certification string   `json:"certification,omitempty"`
+}
+
+// AnticipatedMovie from /movies/anticipated
+type AnticipatedMovie struct {
+	ListCount int   `json:"list_count"`
+	Movie     Movie `json:"movie"`
+}
+
+// TrendingMovie from /movies/trending
+type TrendingMovie struct {
+	Watchers int   `json:"watchers"`
+	Movie    Movie `json:"movie"`
 }
 
 // WatchedMovie from /users/me/watched/movies
@@ -109,3 +129,25 @@ type WatchedMovie struct {
 	LastUpdatedAt time.Time `json:"last_updated_at"`
 	Movie         Movie     `json:"movie"`
 }
+
+// ListItem is an entry of a watchlist or personal list. Season and episode
+// entries carry their show.
+type ListItem struct {
+	Rank     int       `json:"rank"`
+	ListedAt time.Time `json:"listed_at"`
+	Type     string    `json:"type"` // "movie", "show", "season" or "episode"
+	Movie    *Movie    `json:"movie,omitempty"`
+	Show     *Show     `json:"show,omitempty"`
+}
+
+// List is a user's personal list
+type List struct {
+	Name      string  `json:"name"`
+	ItemCount int     `json:"item_count"`
+	IDs       ListIDs `json:"ids"`
+}
+
+type ListIDs struct {
+	Trakt int    `json:"trakt"`
+	Slug  string `json:"slug"`
+}
@@ -4,6 +4,7 @@ import (
 	"fmt"
 	"os"
 	"reflect"
+	"regexp"
 	"strings"
 	"sync"
 	"time"
@@ -20,6 +21,8 @@ type Config struct {
 	Sonarr    SonarrConfig    `mapstructure:"sonarr"`
 	Radarr    RadarrConfig    `mapstructure:"radarr"`
 	Emby      EmbyConfig      `mapstructure:"emby"`
+	Jellyfin  JellyfinConfig  `mapstructure:"jellyfin"`
+	Plex      PlexConfig      `mapstructure:"plex"`
 	Scheduler SchedulerConfig `mapstructure:"scheduler"`
 	Watcher   WatcherConfig   `mapstructure:"watcher"`
 	Cleanup   CleanupConfig   `mapstructure:"cleanup"`
@@ -31,9 +34,18 @@ type ServerConfig struct {
 }
 
 type TraktConfig struct {
-	ClientID     string `mapstructure:"client_id"`
-	ClientSecret string `mapstructure:"client_secret"`
-	BaseURL      string `mapstructure:"base_url"`
+	ClientID     string            `mapstructure:"client_id"`
+	ClientSecret string            `mapstructure:"client_secret"`
+	BaseURL      string            `mapstructure:"base_url"`
+	Name         string            `mapstructure:"name"`  // Name of the primary account in policies and logs (default: me)
+	Users        []TraktUserConfig `mapstructure:"users"` // Additional accounts, each with its own device auth
+}
+
+// TraktUserConfig is an additional Trakt account
+type TraktUserConfig struct {
+	Name            string `mapstructure:"name"`              // Required, unique; letters, digits, - and _
+	TokenFile       string `mapstructure:"token_file"`        // Default: data/trakt_tokens_<name>.json
+	OverseerrUserID int    `mapstructure:"overseerr_user_id"` // Watcher requests as this user (0 = overseerr.user_id)
 }
 
 type OverseerrConfig struct {
@@ -59,6 +71,20 @@ type EmbyConfig struct {
 	ExcludedLibraries []string `mapstructure:"excluded_libraries"`
 }
 
+type JellyfinConfig struct {
+	Enabled           bool     `mapstructure:"enabled"`
+	BaseURL           string   `mapstructure:"base_url"`
+	APIKey            string   `mapstructure:"api_key"`
+	ExcludedLibraries []string `mapstructure:"excluded_libraries"`
+}
+
+type PlexConfig struct {
+	Enabled           bool     `mapstructure:"enabled"`
+	BaseURL           string   `mapstructure:"base_url"`
+	Token             string   `mapstructure:"token"` // X-Plex-Token
+	ExcludedLibraries []string `mapstructure:"excluded_libraries"`
+}
+
 type SchedulerConfig struct {
 	Cron       string `mapstructure:"cron"`
 	DryRun     bool   `mapstructure:"dry_run"`
@@ -66,22 +92,205 @@ type SchedulerConfig struct {
 }
 
 type WatcherConfig struct {
-	Enabled      bool          `mapstructure:"enabled"`
-	CalendarDays int           `mapstructure:"calendar_days"` // Days ahead to check for new episodes
-	Routing      RoutingConfig `mapstructure:"routing"`
+	Enabled      bool                `mapstructure:"enabled"`
+	CalendarDays int                 `mapstructure:"calendar_days"` // Days ahead to check for new episodes
+	Routing      RoutingConfig       `mapstructure:"routing"`
+	Movies       MoviesConfig        `mapstructure:"movies"`
+	Policy       RequestPolicyConfig `mapstructure:"request_policy"`
+	Backfill     BackfillConfig      `mapstructure:"backfill"`
+	RetryFailed  RetryFailedConfig   `mapstructure:"retry_failed"`
+}
+
+// RetryFailedConfig re-submits Overseerr requests that Sonarr/Radarr failed
+// to add. Declined requests are never re-submitted, only reported.
+type RetryFailedConfig struct {
+	Enabled       bool `mapstructure:"enabled"`
+	CooldownHours int  `mapstructure:"cooldown_hours"` // Hours after the failure before retrying (default 24)
+}
+
+// BackfillConfig requests aired seasons of watched shows that are neither
+// available nor requested in Overseerr
+type BackfillConfig struct {
+	Enabled    bool `mapstructure:"enabled"`
+	ActiveDays int  `mapstructure:"active_days"` // Only shows watched in the last N days (0 = every watched show)
+}
+
+// RequestPolicyConfig loosens when the next season is requested. With
+// everything at 0 the previous season must be fully watched.
+type RequestPolicyConfig struct {
+	EpisodesRemaining int  `mapstructure:"episodes_remaining"` // Request when at most N aired episodes of the previous season are left (0 = off)
+	PercentRemaining  int  `mapstructure:"percent_remaining"`  // Request when at most X% of the previous season is left (0 = off)
+	ActiveDays        int  `mapstructure:"active_days"`        // Always request if the show was watched in the last N days (0 = off)
+	Specials          bool `mapstructure:"specials"`           // Also request specials/OVAs (season 0) of shows being watched
+}
+
+// MoviesConfig requests movies from Trakt watchlists via Overseerr
+type MoviesConfig struct {
+	Enabled     bool          `mapstructure:"enabled"`
+	Anticipated int           `mapstructure:"anticipated"` // Also request the top N anticipated movies (0 = off)
+	Trending    int           `mapstructure:"trending"`    // Also request the top N trending movies (0 = off)
+	Routing     RoutingConfig `mapstructure:"routing"`     // Radarr servers have their own IDs in Overseerr
 }
 
 type RoutingConfig struct {
-	DefaultServerID    int      `mapstructure:"default_server_id"`
-	AlternateServerID  int      `mapstructure:"alternate_server_id"`
-	AlternateGenres    []string `mapstructure:"alternate_genres"`
-	AlternateCountries []string `mapstructure:"alternate_countries"`
+	DefaultServerID    int               `mapstructure:"default_server_id"`
+	AlternateServerID  int               `mapstructure:"alternate_server_id"`
+	AlternateGenres    []string          `mapstructure:"alternate_genres"`
+	AlternateCountries []string          `mapstructure:"alternate_countries"`
+	Default            RequestSettings   `mapstructure:"default"`   // Sent with requests for the default server
+	Alternate          RequestSettings   `mapstructure:"alternate"` // Sent with requests for the alternate server
+	Rules              []RoutingRule     `mapstructure:"rules"`     // Checked in order before the alternate/default servers; first match wins
+	Overrides          []RoutingOverride `mapstructure:"overrides"` // Per-show/movie settings, applied over whichever route matched
+}
+
+// RequestSettings are the Overseerr request options a route sets. Zero
+// values leave the choice to the server's defaults in Overseerr.
+type RequestSettings struct {
+	ProfileID         int    `mapstructure:"profile_id"`          // Quality profile (0 = server default)
+	RootFolder        string `mapstructure:"root_folder"`         // "" = server default
+	LanguageProfileID int    `mapstructure:"language_profile_id"` // Sonarr v3 only (0 = server default)
+	Tags              []int  `mapstructure:"tags"`                // Sonarr/Radarr tag IDs
+}
+
+// RoutingRule sends matching requests to a server with its own profiles and
+// root folder. Every condition that's set must match; list conditions match
+// if any entry does. A rule without conditions matches everything.
+type RoutingRule struct {
+	Name           string   `mapstructure:"name"`           // Shown in logs and notifications (default "rule N")
+	Genres         []string `mapstructure:"genres"`         // Trakt genre slugs, e.g. "anime"
+	Countries      []string `mapstructure:"countries"`      // Country codes, e.g. "jp"
+	Networks       []string `mapstructure:"networks"`       // TV networks, e.g. "Netflix"
+	Languages      []string `mapstructure:"languages"`      // Original language codes, e.g. "ko"
+	Certifications []string `mapstructure:"certifications"` // e.g. "TV-MA", "PG-13"
+	MinYear        int      `mapstructure:"min_year"`       // 0 = no lower bound
+	MaxYear        int      `mapstructure:"max_year"`       // 0 = no upper bound
+	TraktLists     []string `mapstructure:"trakt_lists"`    // Personal list names or slugs of any Trakt account
+
+	ServerID        int `mapstructure:"server_id"`
+	RequestSettings `mapstructure:",squash"`
+}
+
+// RoutingOverride changes the settings for one show or movie, matched by
+// TMDB ID or title. Only the fields that are set replace the routed ones.
+type RoutingOverride struct {
+	TMDB            int    `mapstructure:"tmdb"`
+	Title           string `mapstructure:"title"`     // Case-insensitive
+	ServerID        *int   `mapstructure:"server_id"` // nil = keep the routed server
+	RequestSettings `mapstructure:",squash"`
 }
 
 type CleanupConfig struct {
-	Enabled    bool     `mapstructure:"enabled"`
-	DelayDays  int      `mapstructure:"delay_days"` // Days to wait after fully watched
-	Exclusions []string `mapstructure:"exclusions"` // Series titles to never remove
+	Enabled        bool                 `mapstructure:"enabled"`
+	DelayDays      int                  `mapstructure:"delay_days"`   // Days to wait after fully watched
+	RestoreDays    int                  `mapstructure:"restore_days"` // Days deleted items stay restorable (0 = forever)
+	Exclusions     []string             `mapstructure:"exclusions"`   // Series titles to never remove
+	WatchHistory   WatchHistoryConfig   `mapstructure:"watch_history"`
+	WatchPolicy    WatchPolicyConfig    `mapstructure:"watch_policy"`
+	DiskPressure   DiskPressureConfig   `mapstructure:"disk_pressure"`
+	Rules          []CleanupRule        `mapstructure:"rules"` // Evaluated in order, first match wins
+	Seasons        SeasonCleanupConfig  `mapstructure:"seasons"`
+	DailyRetention DailyRetentionConfig `mapstructure:"daily_retention"`
+	Trash          TrashConfig          `mapstructure:"trash"`
+	Rewatch        RewatchConfig        `mapstructure:"rewatch"`
+	Protect        ProtectConfig        `mapstructure:"protect"`
+}
+
+// ProtectConfig keeps items on any Trakt account's watchlist or personal
+// lists from being deleted, whoever finished them
+type ProtectConfig struct {
+	Watchlist bool     `mapstructure:"watchlist"` // Protect watchlisted movies and shows
+	Lists     []string `mapstructure:"lists"`     // Personal list slugs to protect ("*" = every list)
+}
+
+// Rewatch actions
+const (
+	RewatchActionReset  = "reset"  // Restart the item's delay (default)
+	RewatchActionCancel = "cancel" // Take the item off the queue until the rewatch is over
+)
+
+// RewatchConfig pulls queued items back when someone watches them again
+// after they were queued (new Trakt plays or an active Emby session)
+type RewatchConfig struct {
+	Enabled bool   `mapstructure:"enabled"`
+	Action  string `mapstructure:"action"` // "reset" (default) or "cancel"
+}
+
+// TrashConfig soft-deletes Sonarr/Radarr items: the folder is moved to a
+// trash directory, the item is removed without deleting files, and trashed
+// folders are purged after retention_days
+type TrashConfig struct {
+	Enabled       bool          `mapstructure:"enabled"`
+	Path          string        `mapstructure:"path"`           // Trash directory as seen by fusionn-air
+	RetentionDays int           `mapstructure:"retention_days"` // Purge trashed folders older than this
+	PathMappings  []PathMapping `mapstructure:"path_mappings"`  // Sonarr/Radarr paths → fusionn-air paths
+}
+
+// PathMapping translates a Sonarr/Radarr path prefix to the same folder as
+// mounted in fusionn-air
+type PathMapping struct {
+	Remote string `mapstructure:"remote"`
+	Local  string `mapstructure:"local"`
+}
+
+// DailyRetentionConfig keeps a rolling window of episode files for daily
+// shows (Sonarr seriesType "daily") instead of deleting the whole series.
+// An episode is kept if either limit keeps it.
+type DailyRetentionConfig struct {
+	Enabled      bool `mapstructure:"enabled"`
+	KeepEpisodes int  `mapstructure:"keep_episodes"` // Keep the N most recent episode files
+	KeepDays     int  `mapstructure:"keep_days"`     // Keep episodes aired in the last N days
+}
+
+// SeasonCleanupConfig deletes the files of fully watched old seasons while
+// keeping the series in Sonarr (for long-running shows)
+type SeasonCleanupConfig struct {
+	Enabled    bool `mapstructure:"enabled"`
+	MinAgeDays int  `mapstructure:"min_age_days"` // Season's last episode aired at least this long ago
+}
+
+// Cleanup rule actions
+const (
+	RuleActionDelete    = "delete"    // Delete files after the delay (default)
+	RuleActionUnmonitor = "unmonitor" // Only unmonitor in Sonarr/Radarr, keep files
+	RuleActionNever     = "never"     // Never queue or delete
+)
+
+// CleanupRule overrides delay and deletion behaviour for matching items.
+// Every condition that is set must match; empty conditions match anything.
+type CleanupRule struct {
+	Name string `mapstructure:"name"` // Required and unique; queued items follow their rule by name
+
+	Tags            []string `mapstructure:"tags"`             // Sonarr/Radarr tag labels (any)
+	QualityProfiles []string `mapstructure:"quality_profiles"` // Sonarr/Radarr quality profile names (any)
+	RootPaths       []string `mapstructure:"root_paths"`       // Path prefixes (any)
+	Genres          []string `mapstructure:"genres"`           // (any)
+	Networks        []string `mapstructure:"networks"`         // Series network or media server studio (any)
+	Libraries       []string `mapstructure:"libraries"`        // Media server library names (any)
+	YearMin         int      `mapstructure:"year_min"`
+	YearMax         int      `mapstructure:"year_max"`
+
+	DelayDays *int   `mapstructure:"delay_days"` // Overrides cleanup.delay_days
+	Action    string `mapstructure:"action"`     // "delete" (default), "unmonitor", or "never"
+}
+
+// DiskPressureConfig removes queued Sonarr/Radarr items before their delay
+// expires when a root folder runs low on free space
+type DiskPressureConfig struct {
+	Enabled      bool    `mapstructure:"enabled"`
+	MinFreeGB    float64 `mapstructure:"min_free_gb"`    // Start removing below this much free space
+	TargetFreeGB float64 `mapstructure:"target_free_gb"` // Remove until this much is free (default: min_free_gb)
+}
+
+// WatchPolicyConfig decides whose watch history must agree before cleanup
+type WatchPolicyConfig struct {
+	Mode  string   `mapstructure:"mode"`  // "all" (default), "any", or "users"
+	Users []string `mapstructure:"users"` // Account names that must have watched (mode=users)
+}
+
+// WatchHistoryConfig selects where cleanup reads "fully watched" state from
+type WatchHistoryConfig struct {
+	Provider string `mapstructure:"provider"`  // "trakt" (default), "emby" or "plex"
+	EmbyUser string `mapstructure:"emby_user"` // Emby user name or ID (provider=emby)
 }
 
 type AppriseConfig struct {
@@ -95,12 +304,16 @@ type AppriseConfig struct {
 // Services should call Get() at execution time to get fresh config values.
 //
 // Hot-reloadable settings (no restart needed):
-//   - scheduler.dry_run, watcher.calendar_days
-//   - cleanup.delay_days, cleanup.exclusions
+//   - scheduler.dry_run, watcher.calendar_days, watcher.movies, watcher.request_policy
+//   - watcher.backfill, watcher.retry_failed
+//   - cleanup.delay_days, cleanup.exclusions, cleanup.watch_history, cleanup.watch_policy
+//   - cleanup.disk_pressure, cleanup.rules, cleanup.seasons, cleanup.daily_retention
+//   - cleanup.restore_days, cleanup.trash, cleanup.rewatch
+//   - cleanup.protect
 //
 // Requires restart:
 //   - server.port, scheduler.cron
-//   - All API credentials (trakt, overseerr, sonarr, radarr, apprise)
+//   - All API credentials (trakt, overseerr, sonarr, radarr, emby, jellyfin, plex, apprise)
 //   - All *.enabled toggles
 type Manager struct {
 	mu   sync.RWMutex
@@ -131,6 +344,9 @@ func NewManager(path string) (*Manager, error) {
 	if err := viper.Unmarshal(&cfg); err != nil {
 		return nil, err
 	}
+	if err := cfg.validate(); err != nil {
+		return nil, err
+	}
 
 	// Get initial file mod time
 	var lastMod time.Time
@@ -210,6 +426,10 @@ func (m *Manager) reload() {
 		logger.Errorf("❌ Failed to reload config: %v", err)
 		return
 	}
+	if err := newCfg.validate(); err != nil {
+		logger.Errorf("❌ Invalid config, keeping the current one: %v", err)
+		return
+	}
 
 	m.mu.Lock()
 	oldCfg := m.cfg
@@ -289,6 +509,68 @@ func Load(path string) (*Config, error) {
 	if err := viper.Unmarshal(&cfg); err != nil {
 		return nil, err
 	}
+	if err := cfg.validate(); err != nil {
+		return nil, err
+	}
 
 	return &cfg, nil
 }
+
+// traktUserName is what additional Trakt account names may contain. Names
+// become part of their token file path.
+var traktUserName = regexp.MustCompile(`^[A-Za-z0-9_-]+$`)
+
+// validate rejects settings that can't be used safely
+func (c *Config) validate() error {
+	// Each additional Trakt account needs its own name (and token file),
+	// distinct from the primary account's
+	primary := c.Trakt.Name
+	if primary == "" {
+		primary = "me"
+	}
+	users := map[string]bool{strings.ToLower(primary): true}
+	for i, user := range c.Trakt.Users {
+		if !traktUserName.MatchString(user.Name) {
+			return fmt.Errorf("trakt.users[%d]: name %q must be non-empty and contain only letters, digits, - and _", i, user.Name)
+		}
+		key := strings.ToLower(user.Name)
+		if users[key] {
+			return fmt.Errorf("trakt.users[%d]: duplicate name %q", i, user.Name)
+		}
+		users[key] = true
+	}
+
+	// Queued items refer to their cleanup rule by name, so names must
+	// survive rules being added or reordered
+	names := make(map[string]bool, len(c.Cleanup.Rules))
+	for i, rule := range c.Cleanup.Rules {
+		if rule.Name == "" {
+			return fmt.Errorf("cleanup.rules[%d]: name is required", i)
+		}
+		key := strings.ToLower(rule.Name)
+		if names[key] {
+			return fmt.Errorf("cleanup.rules[%d]: duplicate name %q", i, rule.Name)
+		}
+		names[key] = true
+
+		// An unknown action would delete on the Sonarr/Radarr path but keep
+		// orphans, so reject it instead of guessing
+		switch strings.ToLower(rule.Action) {
+		case "", RuleActionDelete, RuleActionUnmonitor, RuleActionNever:
+		default:
+			return fmt.Errorf("cleanup.rules[%d]: unknown action %q (want %s, %s or %s)", i, rule.Action, RuleActionDelete, RuleActionUnmonitor, RuleActionNever)
+		}
+	}
+
+	// Without a keep limit daily retention would delete every watched episode
+	if retention := c.Cleanup.DailyRetention; retention.Enabled && retention.KeepEpisodes <= 0 && retention.KeepDays <= 0 {
+		return fmt.Errorf("cleanup.daily_retention: keep_episodes or keep_days must be greater than 0")
+	}
+
+	switch strings.ToLower(c.Cleanup.Rewatch.Action) {
+	case "", RewatchActionReset, RewatchActionCancel:
+	default:
+		return fmt.Errorf("cleanup.rewatch.action: unknown action %q (want %s or %s)", c.Cleanup.Rewatch.Action, RewatchActionReset, RewatchActionCancel)
+	}
+	return nil
+}
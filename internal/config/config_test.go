@@ -0,0 +1,93 @@
+package config
+
+import (
+	"strings"
+	"testing"
+)
+
+func TestValidate(t *testing.T) {
+	tests := []struct {
+		name    string
+		cfg     Config
+		wantErr string
+	}{
+		{
+			name: "empty config",
+		},
+		{
+			name: "known rule actions",
+			cfg: Config{Cleanup: CleanupConfig{Rules: []CleanupRule{
+				{Name: "default"},
+				{Name: "delete", Action: "delete"},
+				{Name: "unmonitor", Action: "Unmonitor"},
+				{Name: "never", Action: "NEVER"},
+			}}},
+		},
+		{
+			name:    "unknown rule action",
+			cfg:     Config{Cleanup: CleanupConfig{Rules: []CleanupRule{{Name: "kids", Action: "keep"}}}},
+			wantErr: `cleanup.rules[0]: unknown action "keep"`,
+		},
+		{
+			name:    "rule without name",
+			cfg:     Config{Cleanup: CleanupConfig{Rules: []CleanupRule{{Action: "never"}}}},
+			wantErr: "cleanup.rules[0]: name is required",
+		},
+		{
+			name:    "duplicate rule name",
+			cfg:     Config{Cleanup: CleanupConfig{Rules: []CleanupRule{{Name: "Kids"}, {Name: "kids"}}}},
+			wantErr: `cleanup.rules[1]: duplicate name "kids"`,
+		},
+		{
+			name: "known rewatch action",
+			cfg:  Config{Cleanup: CleanupConfig{Rewatch: RewatchConfig{Enabled: true, Action: "Cancel"}}},
+		},
+		{
+			name:    "unknown rewatch action",
+			cfg:     Config{Cleanup: CleanupConfig{Rewatch: RewatchConfig{Enabled: true, Action: "restart"}}},
+			wantErr: `cleanup.rewatch.action: unknown action "restart"`,
+		},
+		{
+			name: "daily retention keeping episodes",
+			cfg:  Config{Cleanup: CleanupConfig{DailyRetention: DailyRetentionConfig{Enabled: true, KeepEpisodes: 10}}},
+		},
+		{
+			name: "daily retention keeping days",
+			cfg:  Config{Cleanup: CleanupConfig{DailyRetention: DailyRetentionConfig{Enabled: true, KeepDays: 7}}},
+		},
+		{
+			name:    "daily retention without keep limit",
+			cfg:     Config{Cleanup: CleanupConfig{DailyRetention: DailyRetentionConfig{Enabled: true}}},
+			wantErr: "cleanup.daily_retention: keep_episodes or keep_days",
+		},
+		{
+			name: "disabled daily retention without keep limit",
+			cfg:  Config{Cleanup: CleanupConfig{DailyRetention: DailyRetentionConfig{KeepDays: -1}}},
+		},
+		{
+			name:    "duplicate trakt user",
+			cfg:     Config{Trakt: TraktConfig{Users: []TraktUserConfig{{Name: "Me"}}}},
+			wantErr: `trakt.users[0]: duplicate name "Me"`,
+		},
+		{
+			name:    "unsafe trakt user name",
+			cfg:     Config{Trakt: TraktConfig{Users: []TraktUserConfig{{Name: "../alice"}}}},
+			wantErr: "trakt.users[0]: name",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			err := tt.cfg.validate()
+			if tt.wantErr == "" {
+				if err != nil {
+					t.Fatalf("validate() = %v, want nil", err)
+				}
+				return
+			}
+			if err == nil || !strings.Contains(err.Error(), tt.wantErr) {
+				t.Fatalf("validate() = %v, want error containing %q", err, tt.wantErr)
+			}
+		})
+	}
+}
@@ -1,10 +1,13 @@
 package handler
 
 import (
+	"errors"
 	"net/http"
+	"strconv"
 
 	"github.com/gin-gonic/gin"
 
+	"github.com/fusionn-air/internal/history"
 	"github.com/fusionn-air/internal/scheduler"
 	"github.com/fusionn-air/internal/service/cleanup"
 	"github.com/fusionn-air/internal/service/watcher"
@@ -14,13 +17,15 @@ type Handler struct {
 	watcher   *watcher.Service
 	cleanup   *cleanup.Service
 	scheduler *scheduler.Scheduler
+	history   *history.Store
 }
 
-func New(watcherService *watcher.Service, cleanupService *cleanup.Service, sched *scheduler.Scheduler) *Handler {
+func New(watcherService *watcher.Service, cleanupService *cleanup.Service, sched *scheduler.Scheduler, historyStore *history.Store) *Handler {
 	return &Handler{
 		watcher:   watcherService,
 		cleanup:   cleanupService,
 		scheduler: sched,
+		history:   historyStore,
 	}
 }
 
@@ -34,11 +39,26 @@ func (h *Handler) RegisterRoutes(r *gin.Engine) {
 		// Watcher endpoints
 		api.GET("/watcher/stats", h.WatcherStats)
 		api.POST("/watcher/run", h.TriggerWatcher)
+		api.POST("/watcher/backfill", h.TriggerBackfill)
 
 		// Cleanup endpoints
 		api.GET("/cleanup/stats", h.CleanupStats)
 		api.GET("/cleanup/queue", h.CleanupQueue)
 		api.POST("/cleanup/run", h.TriggerCleanup)
+		api.GET("/cleanup/ledger", h.CleanupLedger)
+		api.GET("/cleanup/trash", h.CleanupTrash)
+		api.POST("/cleanup/restore/:type/:id", h.RestoreItem)
+		api.DELETE("/cleanup/queue/:type/:id", h.DequeueItem)
+		api.POST("/cleanup/queue/:type/:id/postpone", h.PostponeItem)
+		api.POST("/cleanup/queue/:type/:id/pin", h.PinItem)
+		api.POST("/cleanup/queue/:type/:id/delete", h.ForceDeleteItem)
+		api.GET("/cleanup/pins", h.CleanupPins)
+		api.DELETE("/cleanup/pins/:entry", h.UnpinEntry)
+
+		// History endpoints
+		api.GET("/history/runs", h.ListRuns)
+		api.GET("/history/runs/:id", h.GetRun)
+		api.GET("/history/search", h.SearchHistory)
 
 		// Legacy endpoints (for backwards compatibility)
 		api.GET("/stats", h.WatcherStats)
@@ -96,6 +116,38 @@ func (h *Handler) TriggerWatcher(c *gin.Context) {
 	})
 }
 
+// TriggerBackfill manually requests missing aired seasons of watched shows
+func (h *Handler) TriggerBackfill(c *gin.Context) {
+	if h.watcher == nil {
+		c.JSON(http.StatusOK, gin.H{
+			"enabled": false,
+			"message": "watcher is disabled",
+		})
+		return
+	}
+
+	if !h.watcher.BackfillEnabled() {
+		c.JSON(http.StatusOK, gin.H{
+			"enabled": false,
+			"message": "backfill is disabled",
+		})
+		return
+	}
+
+	results, err := h.watcher.ProcessBackfill(c.Request.Context())
+	if err != nil {
+		c.JSON(http.StatusInternalServerError, gin.H{
+			"error": err.Error(),
+		})
+		return
+	}
+
+	c.JSON(http.StatusOK, gin.H{
+		"message": "backfill processing complete",
+		"results": results,
+	})
+}
+
 // CleanupStats returns cleanup statistics
 func (h *Handler) CleanupStats(c *gin.Context) {
 	if h.cleanup == nil {
@@ -154,3 +206,337 @@ func (h *Handler) TriggerCleanup(c *gin.Context) {
 		"results": results,
 	})
 }
+
+// CleanupLedger returns the deletion ledger
+func (h *Handler) CleanupLedger(c *gin.Context) {
+	if h.cleanup == nil {
+		c.JSON(http.StatusOK, gin.H{
+			"enabled": false,
+			"ledger":  []interface{}{},
+		})
+		return
+	}
+
+	c.JSON(http.StatusOK, gin.H{
+		"enabled": true,
+		"ledger":  h.cleanup.GetLedger(),
+	})
+}
+
+// CleanupTrash returns the folders currently in the trash
+func (h *Handler) CleanupTrash(c *gin.Context) {
+	if h.cleanup == nil {
+		c.JSON(http.StatusOK, gin.H{
+			"enabled": false,
+			"trash":   []interface{}{},
+		})
+		return
+	}
+
+	c.JSON(http.StatusOK, gin.H{
+		"enabled": true,
+		"trash":   h.cleanup.GetTrash(),
+	})
+}
+
+// RestoreItem re-adds a deleted series (TVDB ID) or movie (TMDB ID) to Sonarr/Radarr
+func (h *Handler) RestoreItem(c *gin.Context) {
+	if h.cleanup == nil {
+		c.JSON(http.StatusOK, gin.H{
+			"enabled": false,
+			"message": "cleanup is disabled",
+		})
+		return
+	}
+
+	mediaType := cleanup.MediaType(c.Param("type"))
+	if mediaType != cleanup.MediaTypeSeries && mediaType != cleanup.MediaTypeMovie {
+		c.JSON(http.StatusBadRequest, gin.H{
+			"error": "type must be series or movie",
+		})
+		return
+	}
+
+	id, err := strconv.Atoi(c.Param("id"))
+	if err != nil || id <= 0 {
+		c.JSON(http.StatusBadRequest, gin.H{
+			"error": "id must be a TVDB (series) or TMDB (movie) ID",
+		})
+		return
+	}
+
+	entry, err := h.cleanup.Restore(c.Request.Context(), mediaType, id)
+	if err != nil {
+		status := http.StatusInternalServerError
+		switch {
+		case errors.Is(err, cleanup.ErrNotInLedger):
+			status = http.StatusNotFound
+		case errors.Is(err, cleanup.ErrAlreadyExists):
+			status = http.StatusConflict
+		}
+		c.JSON(status, gin.H{
+			"error": err.Error(),
+		})
+		return
+	}
+
+	c.JSON(http.StatusOK, gin.H{
+		"message": "restored",
+		"item":    entry,
+	})
+}
+
+// queueTarget parses the :type/:id of a queue endpoint, writing a 400 when invalid
+func queueTarget(c *gin.Context) (cleanup.MediaType, int, bool) {
+	id, err := strconv.Atoi(c.Param("id"))
+	if err != nil || id <= 0 {
+		c.JSON(http.StatusBadRequest, gin.H{
+			"error": "id must be the queue item ID",
+		})
+		return "", 0, false
+	}
+	return cleanup.MediaType(c.Param("type")), id, true
+}
+
+// queueError writes the response for a failed queue operation
+func queueError(c *gin.Context, err error) {
+	status := http.StatusInternalServerError
+	switch {
+	case errors.Is(err, cleanup.ErrUnknownMediaType):
+		status = http.StatusBadRequest
+	case errors.Is(err, cleanup.ErrNotQueued):
+		status = http.StatusNotFound
+	case errors.Is(err, cleanup.ErrRuleKeepsFiles):
+		status = http.StatusConflict
+	}
+	c.JSON(status, gin.H{
+		"error": err.Error(),
+	})
+}
+
+// DequeueItem removes an item from the cleanup queue and re-monitors it
+func (h *Handler) DequeueItem(c *gin.Context) {
+	if h.cleanup == nil {
+		c.JSON(http.StatusOK, gin.H{
+			"enabled": false,
+			"message": "cleanup is disabled",
+		})
+		return
+	}
+
+	mediaType, id, ok := queueTarget(c)
+	if !ok {
+		return
+	}
+
+	item, err := h.cleanup.Dequeue(c.Request.Context(), mediaType, id)
+	if err != nil {
+		queueError(c, err)
+		return
+	}
+
+	c.JSON(http.StatusOK, gin.H{
+		"message": "removed from queue",
+		"item":    item,
+	})
+}
+
+// PostponeItem pushes a queued item's removal back ({"days": N})
+func (h *Handler) PostponeItem(c *gin.Context) {
+	if h.cleanup == nil {
+		c.JSON(http.StatusOK, gin.H{
+			"enabled": false,
+			"message": "cleanup is disabled",
+		})
+		return
+	}
+
+	mediaType, id, ok := queueTarget(c)
+	if !ok {
+		return
+	}
+
+	var body struct {
+		Days int `json:"days"`
+	}
+	if err := c.ShouldBindJSON(&body); err != nil || body.Days <= 0 {
+		c.JSON(http.StatusBadRequest, gin.H{
+			"error": "body must be {\"days\": N} with N > 0",
+		})
+		return
+	}
+
+	item, err := h.cleanup.Postpone(mediaType, id, body.Days)
+	if err != nil {
+		queueError(c, err)
+		return
+	}
+
+	c.JSON(http.StatusOK, gin.H{
+		"message": "postponed",
+		"item":    item,
+	})
+}
+
+// PinItem excludes a queued item from cleanup and removes it from the queue
+func (h *Handler) PinItem(c *gin.Context) {
+	if h.cleanup == nil {
+		c.JSON(http.StatusOK, gin.H{
+			"enabled": false,
+			"message": "cleanup is disabled",
+		})
+		return
+	}
+
+	mediaType, id, ok := queueTarget(c)
+	if !ok {
+		return
+	}
+
+	entry, err := h.cleanup.Pin(c.Request.Context(), mediaType, id)
+	if err != nil {
+		queueError(c, err)
+		return
+	}
+
+	c.JSON(http.StatusOK, gin.H{
+		"message": "pinned",
+		"entry":   entry,
+	})
+}
+
+// ForceDeleteItem deletes a queued item now, ignoring its delay
+func (h *Handler) ForceDeleteItem(c *gin.Context) {
+	if h.cleanup == nil {
+		c.JSON(http.StatusOK, gin.H{
+			"enabled": false,
+			"message": "cleanup is disabled",
+		})
+		return
+	}
+
+	mediaType, id, ok := queueTarget(c)
+	if !ok {
+		return
+	}
+
+	results, err := h.cleanup.ForceDelete(c.Request.Context(), mediaType, id)
+	if err != nil {
+		queueError(c, err)
+		return
+	}
+
+	c.JSON(http.StatusOK, gin.H{
+		"message": "force delete complete",
+		"results": results,
+	})
+}
+
+// CleanupPins returns the exclusion entries pinned through the API
+func (h *Handler) CleanupPins(c *gin.Context) {
+	if h.cleanup == nil {
+		c.JSON(http.StatusOK, gin.H{
+			"enabled": false,
+			"pins":    []string{},
+		})
+		return
+	}
+
+	c.JSON(http.StatusOK, gin.H{
+		"enabled": true,
+		"pins":    h.cleanup.GetPins(),
+	})
+}
+
+// UnpinEntry removes a pinned exclusion entry (e.g. tvdb:12345)
+func (h *Handler) UnpinEntry(c *gin.Context) {
+	if h.cleanup == nil {
+		c.JSON(http.StatusOK, gin.H{
+			"enabled": false,
+			"message": "cleanup is disabled",
+		})
+		return
+	}
+
+	entry := c.Param("entry")
+	if err := h.cleanup.Unpin(entry); err != nil {
+		status := http.StatusInternalServerError
+		if errors.Is(err, cleanup.ErrNotPinned) {
+			status = http.StatusNotFound
+		}
+		c.JSON(status, gin.H{
+			"error": err.Error(),
+		})
+		return
+	}
+
+	c.JSON(http.StatusOK, gin.H{
+		"message": "unpinned",
+		"entry":   entry,
+	})
+}
+
+// ListRuns returns recorded runs, newest first (?service=watcher|cleanup&limit=N)
+func (h *Handler) ListRuns(c *gin.Context) {
+	if h.history == nil {
+		c.JSON(http.StatusOK, gin.H{
+			"enabled": false,
+			"runs":    []interface{}{},
+		})
+		return
+	}
+
+	limit, _ := strconv.Atoi(c.DefaultQuery("limit", "50"))
+	c.JSON(http.StatusOK, gin.H{
+		"enabled": true,
+		"runs":    h.history.List(c.Query("service"), limit),
+	})
+}
+
+// GetRun returns one run with all of its results
+func (h *Handler) GetRun(c *gin.Context) {
+	if h.history == nil {
+		c.JSON(http.StatusNotFound, gin.H{
+			"error": "run history is unavailable",
+		})
+		return
+	}
+
+	run := h.history.Get(c.Param("id"))
+	if run == nil {
+		c.JSON(http.StatusNotFound, gin.H{
+			"error": "run not found",
+		})
+		return
+	}
+
+	c.JSON(http.StatusOK, run)
+}
+
+// SearchHistory finds results by title, TVDB or TMDB ID (?title=&tvdb=&tmdb=&limit=)
+func (h *Handler) SearchHistory(c *gin.Context) {
+	if h.history == nil {
+		c.JSON(http.StatusOK, gin.H{
+			"enabled": false,
+			"results": []interface{}{},
+		})
+		return
+	}
+
+	query := history.Query{Title: c.Query("title")}
+	query.TvdbID, _ = strconv.Atoi(c.Query("tvdb"))
+	query.TmdbID, _ = strconv.Atoi(c.Query("tmdb"))
+	query.Limit, _ = strconv.Atoi(c.DefaultQuery("limit", "100"))
+
+	if query.Title == "" && query.TvdbID == 0 && query.TmdbID == 0 {
+		c.JSON(http.StatusBadRequest, gin.H{
+			"error": "one of title, tvdb or tmdb is required",
+		})
+		return
+	}
+
+	c.JSON(http.StatusOK, gin.H{
+		"enabled": true,
+		"results": h.history.Search(query),
+	})
+}
@@ -0,0 +1,346 @@
+package history
+
+import (
+	"bufio"
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"os"
+	"path/filepath"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/fusionn-air/pkg/fileutil"
+	"github.com/fusionn-air/pkg/logger"
+)
+
+// Services that record runs
+const (
+	ServiceWatcher = "watcher"
+	ServiceCleanup = "cleanup"
+)
+
+// ActionSkipped is the action of results that were left alone
+const ActionSkipped = "skipped"
+
+// maxItems bounds the history file by results stored; the oldest runs are
+// dropped on compaction until the rest fit. A var so tests can lower it.
+var maxItems = 50000
+
+// Run is one watcher or cleanup run
+type Run struct {
+	ID         string         `json:"id"`
+	Service    string         `json:"service"`
+	StartedAt  time.Time      `json:"started_at"`
+	FinishedAt time.Time      `json:"finished_at"`
+	DryRun     bool           `json:"dry_run"`
+	Error      string         `json:"error,omitempty"`
+	Summary    map[string]int `json:"summary"`         // Result count per action, skipped included
+	Items      []Item         `json:"items,omitempty"` // Results; skipped ones only when their reason changed
+}
+
+// Item is one result within a run (a watcher ProcessResult or cleanup MediaResult)
+type Item struct {
+	Type       string `json:"type"`
+	Title      string `json:"title"`
+	ID         int    `json:"id,omitempty"` // Sonarr/Radarr/media server ID
+	TvdbID     int    `json:"tvdb_id,omitempty"`
+	TmdbID     int    `json:"tmdb_id,omitempty"`
+	Season     int    `json:"season,omitempty"`
+	Action     string `json:"action"`
+	Reason     string `json:"reason,omitempty"`
+	User       string `json:"user,omitempty"`
+	SizeOnDisk string `json:"size_on_disk,omitempty"`
+}
+
+// key identifies the media an item is about across runs
+func (i Item) key() string {
+	return fmt.Sprintf("%s|%d|%d|%d|%d", i.Type, i.ID, i.TvdbID, i.TmdbID, i.Season)
+}
+
+// Match is a search hit: an item plus the run it belongs to
+type Match struct {
+	RunID     string    `json:"run_id"`
+	Service   string    `json:"service"`
+	StartedAt time.Time `json:"started_at"`
+	DryRun    bool      `json:"dry_run"`
+	Item      Item      `json:"item"`
+}
+
+// Query filters a search; zero fields match anything
+type Query struct {
+	Title  string // Case-insensitive substring
+	TvdbID int
+	TmdbID int
+	Limit  int
+}
+
+// Store is an append-only run history kept in a JSON Lines file. Each run is
+// one line, appended and synced, so a crash can at worst lose the last line.
+// Runs are indexed in memory by TVDB/TMDB ID for searches.
+type Store struct {
+	mu       sync.RWMutex
+	runs     []*Run // oldest first
+	items    int    // Items across all runs
+	byID     map[string]*Run
+	byTvdb   map[int][]*Run    // TVDB ID → runs with an item for it, oldest first
+	byTmdb   map[int][]*Run    // TMDB ID → runs with an item for it, oldest first
+	lastSkip map[string]string // Item key → reason of its latest recorded skip
+	filePath string
+}
+
+// Open loads the history file, skipping unreadable lines
+func Open(path string) (*Store, error) {
+	s := &Store{
+		byID:     make(map[string]*Run),
+		byTvdb:   make(map[int][]*Run),
+		byTmdb:   make(map[int][]*Run),
+		lastSkip: make(map[string]string),
+		filePath: path,
+	}
+
+	if err := os.MkdirAll(filepath.Dir(path), 0o755); err != nil {
+		return nil, fmt.Errorf("creating history dir: %w", err)
+	}
+
+	f, err := os.Open(path)
+	if os.IsNotExist(err) {
+		return s, nil
+	}
+	if err != nil {
+		return nil, fmt.Errorf("opening history: %w", err)
+	}
+	defer f.Close()
+
+	scanner := bufio.NewScanner(f)
+	scanner.Buffer(make([]byte, 0, 64*1024), 16*1024*1024)
+	skipped := 0
+	for scanner.Scan() {
+		var run Run
+		if err := json.Unmarshal(scanner.Bytes(), &run); err != nil || run.ID == "" {
+			skipped++
+			continue
+		}
+		s.add(&run)
+	}
+	if err := scanner.Err(); err != nil {
+		return nil, fmt.Errorf("reading history: %w", err)
+	}
+	if skipped > 0 || s.items > maxItems {
+		// Rewrite so a torn last line doesn't swallow the next append
+		if skipped > 0 {
+			logger.Warnf("⚠️  Skipped %d unreadable run(s) in %s", skipped, path)
+		}
+		if err := s.compact(); err != nil {
+			return nil, err
+		}
+	}
+
+	return s, nil
+}
+
+// Record assigns the run an ID and appends it to the history. Skipped items
+// repeat every run, so they're only kept when an item is skipped for the
+// first time or for a different reason than last time; the summary still
+// counts them all.
+func (s *Store) Record(run *Run) error {
+	if s == nil {
+		return nil
+	}
+
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	run.ID = s.newID(run.Service, run.StartedAt)
+	if run.Summary == nil {
+		run.Summary = make(map[string]int)
+		for _, item := range run.Items {
+			run.Summary[item.Action]++
+		}
+	}
+
+	items := run.Items[:0:0]
+	for _, item := range run.Items {
+		if item.Action == ActionSkipped {
+			if reason, seen := s.lastSkip[item.key()]; seen && reason == item.Reason {
+				continue
+			}
+		}
+		items = append(items, item)
+	}
+	run.Items = items
+
+	line, err := json.Marshal(run)
+	if err != nil {
+		return fmt.Errorf("encoding run: %w", err)
+	}
+
+	f, err := os.OpenFile(s.filePath, os.O_CREATE|os.O_WRONLY|os.O_APPEND, 0o600)
+	if err != nil {
+		return fmt.Errorf("opening history: %w", err)
+	}
+	if _, err := f.Write(append(line, '\n')); err != nil {
+		f.Close()
+		return fmt.Errorf("writing history: %w", err)
+	}
+	if err := f.Sync(); err != nil {
+		f.Close()
+		return fmt.Errorf("syncing history: %w", err)
+	}
+	if err := f.Close(); err != nil {
+		return fmt.Errorf("closing history: %w", err)
+	}
+
+	s.add(run)
+
+	if s.items > maxItems {
+		return s.compact()
+	}
+	return nil
+}
+
+// add appends a run to the in-memory history and its indexes
+func (s *Store) add(run *Run) {
+	s.runs = append(s.runs, run)
+	s.items += len(run.Items)
+	s.byID[run.ID] = run
+
+	tvdb := make(map[int]bool)
+	tmdb := make(map[int]bool)
+	for _, item := range run.Items {
+		if item.Action == ActionSkipped {
+			s.lastSkip[item.key()] = item.Reason
+		} else {
+			delete(s.lastSkip, item.key())
+		}
+		if item.TvdbID > 0 && !tvdb[item.TvdbID] {
+			tvdb[item.TvdbID] = true
+			s.byTvdb[item.TvdbID] = append(s.byTvdb[item.TvdbID], run)
+		}
+		if item.TmdbID > 0 && !tmdb[item.TmdbID] {
+			tmdb[item.TmdbID] = true
+			s.byTmdb[item.TmdbID] = append(s.byTmdb[item.TmdbID], run)
+		}
+	}
+}
+
+// newID returns a readable, unique run ID like cleanup-20260101-120000
+func (s *Store) newID(service string, startedAt time.Time) string {
+	base := fmt.Sprintf("%s-%s", service, startedAt.UTC().Format("20060102-150405"))
+	id := base
+	for n := 2; s.byID[id] != nil; n++ {
+		id = fmt.Sprintf("%s-%d", base, n)
+	}
+	return id
+}
+
+// compact drops the oldest runs until at most maxItems remain and rewrites
+// the file atomically, also clearing out unreadable lines
+func (s *Store) compact() error {
+	if s.items > maxItems {
+		drop, items := 0, s.items
+		for drop < len(s.runs) && items > maxItems {
+			items -= len(s.runs[drop].Items)
+			drop++
+		}
+		kept := s.runs[drop:]
+		s.runs = nil
+		s.items = 0
+		s.byID = make(map[string]*Run)
+		s.byTvdb = make(map[int][]*Run)
+		s.byTmdb = make(map[int][]*Run)
+		s.lastSkip = make(map[string]string)
+		for _, run := range kept {
+			s.add(run)
+		}
+	}
+
+	var buf bytes.Buffer
+	for _, run := range s.runs {
+		line, err := json.Marshal(run)
+		if err != nil {
+			return fmt.Errorf("encoding run: %w", err)
+		}
+		buf.Write(line)
+		buf.WriteByte('\n')
+	}
+
+	if err := fileutil.WriteAtomic(s.filePath, buf.Bytes()); err != nil {
+		return fmt.Errorf("compacting history: %w", err)
+	}
+	return nil
+}
+
+// List returns runs newest first without their items, optionally for one service
+func (s *Store) List(service string, limit int) []Run {
+	s.mu.RLock()
+	defer s.mu.RUnlock()
+
+	var runs []Run
+	for i := len(s.runs) - 1; i >= 0; i-- {
+		run := *s.runs[i]
+		if service != "" && run.Service != service {
+			continue
+		}
+		run.Items = nil
+		runs = append(runs, run)
+		if limit > 0 && len(runs) >= limit {
+			break
+		}
+	}
+	return runs
+}
+
+// Get returns a run with its items, or nil
+func (s *Store) Get(id string) *Run {
+	s.mu.RLock()
+	defer s.mu.RUnlock()
+
+	return s.byID[id]
+}
+
+// Search returns items matching the query, newest first. ID queries only
+// scan the runs indexed under that ID.
+func (s *Store) Search(q Query) []Match {
+	s.mu.RLock()
+	defer s.mu.RUnlock()
+
+	title := strings.ToLower(q.Title)
+
+	runs := s.runs
+	switch {
+	case q.TvdbID > 0:
+		runs = s.byTvdb[q.TvdbID]
+	case q.TmdbID > 0:
+		runs = s.byTmdb[q.TmdbID]
+	}
+
+	var matches []Match
+	for i := len(runs) - 1; i >= 0; i-- {
+		run := runs[i]
+		for _, item := range run.Items {
+			if title != "" && !strings.Contains(strings.ToLower(item.Title), title) {
+				continue
+			}
+			if q.TvdbID > 0 && item.TvdbID != q.TvdbID {
+				continue
+			}
+			if q.TmdbID > 0 && item.TmdbID != q.TmdbID {
+				continue
+			}
+			matches = append(matches, Match{
+				RunID:     run.ID,
+				Service:   run.Service,
+				StartedAt: run.StartedAt,
+				DryRun:    run.DryRun,
+				Item:      item,
+			})
+			if q.Limit > 0 && len(matches) >= q.Limit {
+				return matches
+			}
+		}
+	}
+
+	return matches
+}
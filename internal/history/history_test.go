@@ -0,0 +1,199 @@
+package history
+
+import (
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/fusionn-air/pkg/logger"
+)
+
+func TestMain(m *testing.M) {
+	logger.Init(false)
+	os.Exit(m.Run())
+}
+
+func openTestStore(t *testing.T, path string) *Store {
+	t.Helper()
+	s, err := Open(path)
+	if err != nil {
+		t.Fatalf("Open() error = %v", err)
+	}
+	return s
+}
+
+func record(t *testing.T, s *Store, service string, items ...Item) *Run {
+	t.Helper()
+	run := &Run{Service: service, StartedAt: time.Now(), FinishedAt: time.Now(), Items: items}
+	if err := s.Record(run); err != nil {
+		t.Fatalf("Record() error = %v", err)
+	}
+	return run
+}
+
+func TestStoreSearch(t *testing.T) {
+	s := openTestStore(t, filepath.Join(t.TempDir(), "history.jsonl"))
+	record(t, s, ServiceWatcher,
+		Item{Type: "season", Title: "Breaking Bad", TmdbID: 1396, Season: 1, Action: "requested"},
+		Item{Type: "movie", Title: "The Matrix", TmdbID: 603, Action: "requested"},
+	)
+	record(t, s, ServiceCleanup,
+		Item{Type: "series", Title: "Breaking Bad", TvdbID: 81189, Action: "queued"},
+		Item{Type: "movie", Title: "The Matrix", TmdbID: 603, Action: "removed"},
+	)
+
+	tests := []struct {
+		name        string
+		query       Query
+		wantActions []string // Newest first
+	}{
+		{"title is case-insensitive", Query{Title: "breaking"}, []string{"queued", "requested"}},
+		{"title substring", Query{Title: "matrix"}, []string{"removed", "requested"}},
+		{"tvdb", Query{TvdbID: 81189}, []string{"queued"}},
+		{"tmdb", Query{TmdbID: 603}, []string{"removed", "requested"}},
+		{"tmdb and title", Query{TmdbID: 1396, Title: "matrix"}, nil},
+		{"limit", Query{Title: "breaking", Limit: 1}, []string{"queued"}},
+		{"no match", Query{TvdbID: 1}, nil},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			matches := s.Search(tt.query)
+			var got []string
+			for _, m := range matches {
+				got = append(got, m.Item.Action)
+			}
+			if strings.Join(got, ",") != strings.Join(tt.wantActions, ",") {
+				t.Errorf("Search(%+v) actions = %v, want %v", tt.query, got, tt.wantActions)
+			}
+		})
+	}
+}
+
+func TestStoreCompaction(t *testing.T) {
+	defer func(n int) { maxItems = n }(maxItems)
+	maxItems = 3
+
+	path := filepath.Join(t.TempDir(), "history.jsonl")
+	s := openTestStore(t, path)
+
+	var runs []*Run
+	start := time.Now().Add(-time.Hour)
+	for i := 1; i <= 3; i++ {
+		run := &Run{
+			Service:   ServiceCleanup,
+			StartedAt: start.Add(time.Duration(i) * time.Minute),
+			Items: []Item{
+				{Type: "movie", Title: "Old", TmdbID: i, Action: "removed"},
+				{Type: "movie", Title: "New", TmdbID: 100 + i, Action: "removed"},
+			},
+		}
+		if err := s.Record(run); err != nil {
+			t.Fatalf("Record() error = %v", err)
+		}
+		runs = append(runs, run)
+	}
+
+	tests := []struct {
+		name string
+		s    *Store
+	}{
+		{"in memory", s},
+		{"reloaded", openTestStore(t, path)},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := len(tt.s.List("", 0)); got != 1 {
+				t.Errorf("List() returned %d runs, want 1", got)
+			}
+			if tt.s.Get(runs[0].ID) != nil || tt.s.Get(runs[1].ID) != nil {
+				t.Error("oldest runs weren't dropped")
+			}
+			if tt.s.Get(runs[2].ID) == nil {
+				t.Error("newest run was dropped")
+			}
+			if got := tt.s.Search(Query{TmdbID: 1}); len(got) != 0 {
+				t.Errorf("dropped run still indexed: %+v", got)
+			}
+		})
+	}
+
+	if _, err := os.Stat(path); err != nil {
+		t.Fatalf("history file missing after compaction: %v", err)
+	}
+	entries, err := os.ReadDir(filepath.Dir(path))
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(entries) != 1 {
+		t.Errorf("history dir has %d files, want only the history (no leftover temp files)", len(entries))
+	}
+}
+
+func TestStoreDropsTornLines(t *testing.T) {
+	path := filepath.Join(t.TempDir(), "history.jsonl")
+	s := openTestStore(t, path)
+	run := record(t, s, ServiceWatcher, Item{Type: "movie", Title: "The Matrix", TmdbID: 603, Action: "requested"})
+
+	f, err := os.OpenFile(path, os.O_WRONLY|os.O_APPEND, 0o600)
+	if err != nil {
+		t.Fatal(err)
+	}
+	_, _ = f.WriteString(`{"id": "torn`)
+	f.Close()
+
+	s = openTestStore(t, path)
+	if s.Get(run.ID) == nil {
+		t.Fatal("readable run lost on reload")
+	}
+	next := record(t, s, ServiceWatcher, Item{Type: "movie", Title: "Dune", TmdbID: 438631, Action: "requested"})
+
+	s = openTestStore(t, path)
+	if s.Get(next.ID) == nil {
+		t.Error("run appended after a torn line was lost")
+	}
+}
+
+func TestStoreSkippedItems(t *testing.T) {
+	skipped := func(reason string) Item {
+		return Item{Type: "season", Title: "Breaking Bad", TmdbID: 1396, Season: 2, Action: ActionSkipped, Reason: reason}
+	}
+
+	tests := []struct {
+		name  string
+		items []Item // One run each
+		want  []int  // Items kept per run
+	}{
+		{"first skip is kept", []Item{skipped("not watched")}, []int{1}},
+		{"same reason is dropped", []Item{skipped("not watched"), skipped("not watched")}, []int{1, 0}},
+		{"changed reason is kept", []Item{skipped("not watched"), skipped("already available")}, []int{1, 1}},
+		{
+			name: "skip after another action is kept",
+			items: []Item{
+				skipped("not watched"),
+				{Type: "season", Title: "Breaking Bad", TmdbID: 1396, Season: 2, Action: "error", Reason: "timeout"},
+				skipped("not watched"),
+			},
+			want: []int{1, 1, 1},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			path := filepath.Join(t.TempDir(), "history.jsonl")
+			for i, item := range tt.items {
+				// Reopen each time so the last skip reason must survive a restart
+				run := record(t, openTestStore(t, path), ServiceWatcher, item)
+				if got := len(run.Items); got != tt.want[i] {
+					t.Errorf("run %d kept %d items, want %d", i+1, got, tt.want[i])
+				}
+				if got := run.Summary[item.Action]; got != 1 {
+					t.Errorf("run %d summary counts %d %q, want 1", i+1, got, item.Action)
+				}
+			}
+		})
+	}
+}
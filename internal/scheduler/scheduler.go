@@ -97,6 +97,9 @@ func (s *Scheduler) runWatcher() {
 	if err != nil {
 		logger.Errorf("❌ Watcher job failed: %v", err)
 	}
+	if _, err := s.watcher.ProcessBackfill(ctx); err != nil {
+		logger.Errorf("❌ Backfill job failed: %v", err)
+	}
 }
 
 func (s *Scheduler) runCleanup() {
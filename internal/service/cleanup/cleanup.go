@@ -2,16 +2,19 @@ package cleanup
 
 import (
 	"context"
-	"strings"
+	"fmt"
 	"sync"
 	"time"
 
 	"github.com/fusionn-air/internal/client/apprise"
 	"github.com/fusionn-air/internal/client/emby"
+	"github.com/fusionn-air/internal/client/jellyfin"
+	"github.com/fusionn-air/internal/client/plex"
 	"github.com/fusionn-air/internal/client/radarr"
 	"github.com/fusionn-air/internal/client/sonarr"
 	"github.com/fusionn-air/internal/client/trakt"
 	"github.com/fusionn-air/internal/config"
+	"github.com/fusionn-air/internal/history"
 	"github.com/fusionn-air/pkg/logger"
 )
 
@@ -23,22 +26,40 @@ const (
 	MediaTypeMovie      MediaType = "movie"
 	MediaTypeEmbySeries MediaType = "emby_series"
 	MediaTypeEmbyMovie  MediaType = "emby_movie"
+
+	MediaTypeJellyfinSeries MediaType = "jellyfin_series"
+	MediaTypeJellyfinMovie  MediaType = "jellyfin_movie"
+
+	MediaTypePlexSeries MediaType = "plex_series"
+	MediaTypePlexMovie  MediaType = "plex_movie"
 )
 
 // Service handles cleanup of fully watched media
 type Service struct {
-	sonarr  *sonarr.Client
-	radarr  *radarr.Client
-	emby    *emby.Client
-	trakt   *trakt.Client
-	apprise *apprise.Client
-
-	cfgMgr *config.Manager
-	queues map[MediaType]*Queue
-
+	sonarr   *sonarr.Client
+	radarr   *radarr.Client
+	emby     *emby.Client
+	jellyfin *jellyfin.Client
+	plex     *plex.Client
+	trakt    *trakt.Client
+	apprise  *apprise.Client
+
+	// Additional Trakt accounts evaluated by the watch policy
+	traktUsers []*trakt.Client
+
+	cfgMgr  *config.Manager
+	queues  map[MediaType]*Queue
+	ledger  *Ledger
+	trash   *Trash
+	pins    *Pins
+	holds   *Holds
+	history *history.Store
+
+	runMu       sync.Mutex // Serializes cleanup runs and force deletes
 	mu          sync.RWMutex
 	lastRun     time.Time
 	lastResults *ProcessingResult
+	protected   *protectedSet // Trakt watchlist/list items, refreshed each run
 }
 
 // MediaResult holds the result for any media item (series, movie, etc.)
@@ -46,8 +67,9 @@ type MediaResult struct {
 	Type       MediaType `json:"type"`
 	Title      string    `json:"title"`
 	ID         int       `json:"id"`
-	Year       int       `json:"year,omitempty"` // For movies
-	Action     string    `json:"action"`         // "queued", "removed", "skipped", "error"
+	ExternalID int       `json:"external_id,omitempty"` // TVDB for series, TMDB for movies
+	Year       int       `json:"year,omitempty"`        // For movies
+	Action     string    `json:"action"`                // "queued", "removed", "skipped", "error"
 	Reason     string    `json:"reason"`
 	DaysUntil  int       `json:"days_until,omitempty"`
 	SizeOnDisk string    `json:"size_on_disk,omitempty"`
@@ -73,23 +95,39 @@ type MediaStats struct {
 	Skipped        int `json:"skipped"`
 }
 
-func NewService(sonarrClient *sonarr.Client, radarrClient *radarr.Client, embyClient *emby.Client, traktClient *trakt.Client, appriseClient *apprise.Client, cfgMgr *config.Manager) *Service {
+func NewService(sonarrClient *sonarr.Client, radarrClient *radarr.Client, embyClient *emby.Client, jellyfinClient *jellyfin.Client, plexClient *plex.Client, traktClient *trakt.Client, traktUsers []*trakt.Client, appriseClient *apprise.Client, cfgMgr *config.Manager, queueStore QueueStore, historyStore *history.Store) (*Service, error) {
 	s := &Service{
-		sonarr:  sonarrClient,
-		radarr:  radarrClient,
-		emby:    embyClient,
-		trakt:   traktClient,
-		apprise: appriseClient,
-		cfgMgr:  cfgMgr,
-		queues:  make(map[MediaType]*Queue),
+		sonarr:     sonarrClient,
+		radarr:     radarrClient,
+		emby:       embyClient,
+		jellyfin:   jellyfinClient,
+		plex:       plexClient,
+		trakt:      traktClient,
+		traktUsers: traktUsers,
+		apprise:    appriseClient,
+		cfgMgr:     cfgMgr,
+		queues:     make(map[MediaType]*Queue),
+		history:    historyStore,
 	}
 
-	s.queues[MediaTypeSeries] = NewQueueWithFile("data/cleanup_series_queue.json")
-	s.queues[MediaTypeMovie] = NewQueueWithFile("data/cleanup_movie_queue.json")
-	s.queues[MediaTypeEmbySeries] = NewQueueWithFile("data/cleanup_emby_series_queue.json")
-	s.queues[MediaTypeEmbyMovie] = NewQueueWithFile("data/cleanup_emby_movie_queue.json")
+	for _, t := range []MediaType{
+		MediaTypeSeries, MediaTypeMovie,
+		MediaTypeEmbySeries, MediaTypeEmbyMovie,
+		MediaTypeJellyfinSeries, MediaTypeJellyfinMovie,
+		MediaTypePlexSeries, MediaTypePlexMovie,
+	} {
+		queue, err := NewQueue(t, queueStore)
+		if err != nil {
+			return nil, err
+		}
+		s.queues[t] = queue
+	}
+	s.ledger = NewLedgerWithFile("data/cleanup_ledger.json")
+	s.trash = NewTrashWithFile("data/cleanup_trash.json")
+	s.pins = NewPinsWithFile("data/cleanup_pins.json")
+	s.holds = NewHoldsWithFile("data/cleanup_holds.json")
 
-	return s
+	return s, nil
 }
 
 // ProcessCleanup runs the cleanup logic for all media types
@@ -102,6 +140,9 @@ func (s *Service) ProcessCleanup(ctx context.Context) (*ProcessingResult, error)
 		return nil, nil
 	}
 
+	s.runMu.Lock()
+	defer s.runMu.Unlock()
+
 	startTime := time.Now()
 	dryRun := cfg.Scheduler.DryRun
 
@@ -118,69 +159,23 @@ func (s *Service) ProcessCleanup(ctx context.Context) (*ProcessingResult, error)
 		Stats: make(map[MediaType]*MediaStats),
 	}
 
-	sonarrTvdbIDs := s.processSeries(ctx, result, cfg, dryRun)
-	radarrTmdbIDs := s.processMovies(ctx, result, cfg, dryRun)
-
-	if s.emby != nil && cfg.Emby.Enabled {
-		libraries, excludedLibNames := s.resolveLibrariesAndExclusions(ctx, cfg)
-
-		// Aggregate items by type from all libraries
-		var allMovies []emby.Item
-		var allSeries []emby.Item
-
-		for _, lib := range libraries {
-			if excludedLibNames[lib.Name] {
-				logger.Infof("📚 Skipping excluded library %q (ID: %s)", lib.Name, lib.ItemID)
-				continue
-			}
-
-			switch lib.CollectionType {
-			case "movies":
-				if radarrTmdbIDs == nil {
-					logger.Warnf("⚠️  Skipping movie library %q - Radarr data unavailable", lib.Name)
-					continue
-				}
-				movies, err := s.emby.GetMovies(ctx, lib.ItemID)
-				if err != nil {
-					logger.Errorf("❌ Failed to get movies from library %q: %v", lib.Name, err)
-					continue
-				}
-				logger.Infof("🎬 Found %d movies in library %q", len(movies), lib.Name)
-				allMovies = append(allMovies, movies...)
-
-			case "tvshows":
-				if sonarrTvdbIDs == nil {
-					logger.Warnf("⚠️  Skipping TV library %q - Sonarr data unavailable", lib.Name)
-					continue
-				}
-				series, err := s.emby.GetSeries(ctx, lib.ItemID)
-				if err != nil {
-					logger.Errorf("❌ Failed to get series from library %q: %v", lib.Name, err)
-					continue
-				}
-				logger.Infof("📺 Found %d series in library %q", len(series), lib.Name)
-				allSeries = append(allSeries, series...)
-
-			default:
-				if lib.CollectionType != "" {
-					logger.Debugf("📚 Skipping library %q (unsupported type: %s)", lib.Name, lib.CollectionType)
-				} else {
-					logger.Debugf("📚 Skipping library %q (mixed content not supported)", lib.Name)
-				}
-			}
+	if cfg.Cleanup.RestoreDays > 0 {
+		if pruned := s.ledger.Prune(cfg.Cleanup.RestoreDays); pruned > 0 {
+			logger.Infof("📒 Pruned %d deletion ledger entries older than %d days", pruned, cfg.Cleanup.RestoreDays)
 		}
+	}
+	s.purgeTrash(cfg, dryRun)
+	if err := s.refreshProtected(ctx, cfg); err != nil {
+		logger.Errorf("❌ Failed to load protected Trakt lists, skipping cleanup this run: %v", err)
+		return nil, fmt.Errorf("loading protected lists: %w", err)
+	}
 
-		// Process aggregated items (fetches Trakt data once per type)
-		if len(allMovies) > 0 {
-			s.processEmbyMovieItems(ctx, result, cfg, dryRun, radarrTmdbIDs, allMovies)
-		} else if radarrTmdbIDs != nil {
-			logger.Info("🎬 No movies found in non-excluded movie libraries")
-		}
+	sonarrTvdbIDs := s.processSeries(ctx, result, cfg, dryRun)
+	radarrTmdbIDs := s.processMovies(ctx, result, cfg, dryRun)
 
-		if len(allSeries) > 0 {
-			s.processEmbySeriesItems(ctx, result, cfg, dryRun, sonarrTvdbIDs, allSeries)
-		} else if sonarrTvdbIDs != nil {
-			logger.Info("📺 No series found in non-excluded TV libraries")
+	for _, server := range s.mediaServers() {
+		if server.Enabled(cfg) {
+			s.processMediaServer(ctx, result, cfg, dryRun, server, sonarrTvdbIDs, radarrTmdbIDs)
 		}
 	}
 
@@ -190,6 +185,8 @@ func (s *Service) ProcessCleanup(ctx context.Context) (*ProcessingResult, error)
 	s.lastResults = result
 	s.mu.Unlock()
 
+	s.recordRun(result, startTime, dryRun)
+
 	// Print summary and send notification
 	s.printSummary(result, startTime, dryRun)
 	s.sendNotification(ctx, result, dryRun)
@@ -197,40 +194,33 @@ func (s *Service) ProcessCleanup(ctx context.Context) (*ProcessingResult, error)
 	return result, nil
 }
 
-// resolveLibrariesAndExclusions fetches Emby libraries and builds a map of excluded library names.
-// Returns all libraries and a map of excluded names for filtering.
-func (s *Service) resolveLibrariesAndExclusions(ctx context.Context, cfg *config.Config) ([]emby.VirtualFolder, map[string]bool) {
-	libraries, err := s.emby.GetLibraries(ctx)
-	if err != nil {
-		logger.Warnf("⚠️  Failed to fetch Emby libraries: %v — proceeding without library filtering", err)
-		return nil, nil
-	}
-
-	if len(cfg.Emby.ExcludedLibraries) == 0 {
-		return libraries, make(map[string]bool)
+// excludedLibrarySet builds a lookup of excluded library names, warning about
+// configured names that don't exist on the media server.
+func excludedLibrarySet(server string, libraryNames, excluded []string) map[string]bool {
+	if len(excluded) == 0 {
+		return make(map[string]bool)
 	}
 
-	// Build map of excluded library names (case-insensitive)
-	excludedNames := make(map[string]bool, len(cfg.Emby.ExcludedLibraries))
-	for _, name := range cfg.Emby.ExcludedLibraries {
+	excludedNames := make(map[string]bool, len(excluded))
+	for _, name := range excluded {
 		excludedNames[name] = true
 	}
 
 	// Validate that excluded names exist and log exclusions
-	libsByName := make(map[string]bool, len(libraries))
-	for _, lib := range libraries {
-		libsByName[lib.Name] = true
+	libsByName := make(map[string]bool, len(libraryNames))
+	for _, name := range libraryNames {
+		libsByName[name] = true
 	}
 
-	for _, name := range cfg.Emby.ExcludedLibraries {
+	for _, name := range excluded {
 		if !libsByName[name] {
-			logger.Warnf("⚠️  Excluded library %q not found in Emby — check spelling", name)
+			logger.Warnf("⚠️  Excluded library %q not found in %s — check spelling", name, server)
 		} else {
-			logger.Infof("🚫 Excluding Emby library %q from cleanup", name)
+			logger.Infof("🚫 Excluding %s library %q from cleanup", server, name)
 		}
 	}
 
-	return libraries, excludedNames
+	return excludedNames
 }
 
 // GetQueue returns the queue for a specific media type
@@ -247,12 +237,43 @@ func (s *Service) GetAllQueues() []*QueueItem {
 	return all
 }
 
-// isExcluded checks if a title is in the exclusion list (shared across all types)
-func isExcluded(title string, exclusions []string) bool {
-	for _, exc := range exclusions {
-		if strings.EqualFold(exc, title) {
-			return true
+// recordRun saves a cleanup run to the history store
+func (s *Service) recordRun(result *ProcessingResult, startTime time.Time, dryRun bool) {
+	run := &history.Run{
+		Service:    history.ServiceCleanup,
+		StartedAt:  startTime,
+		FinishedAt: time.Now(),
+		DryRun:     dryRun,
+		Summary:    make(map[string]int),
+	}
+	for _, r := range result.Results {
+		run.Summary[r.Action]++
+		item := history.Item{
+			Type:       string(r.Type),
+			Title:      r.Title,
+			ID:         r.ID,
+			Action:     r.Action,
+			Reason:     r.Reason,
+			SizeOnDisk: r.SizeOnDisk,
 		}
+		if r.Type.isSeries() {
+			item.TvdbID = r.ExternalID
+		} else {
+			item.TmdbID = r.ExternalID
+		}
+		run.Items = append(run.Items, item)
+	}
+
+	if err := s.history.Record(run); err != nil {
+		logger.Warnf("⚠️  Failed to save run history: %v", err)
+	}
+}
+
+// isSeries reports whether the media type is a TV series (TVDB IDs)
+func (t MediaType) isSeries() bool {
+	switch t {
+	case MediaTypeSeries, MediaTypeEmbySeries, MediaTypeJellyfinSeries, MediaTypePlexSeries:
+		return true
 	}
 	return false
 }
@@ -296,6 +317,17 @@ func (r *ProcessingResult) AddResult(res MediaResult) {
 	}
 }
 
+// dropQueued removes the "queued" result for an item that is being removed in the same run
+func (r *ProcessingResult) dropQueued(t MediaType, id int) {
+	for i, res := range r.Results {
+		if res.Type == t && res.ID == id && res.Action == "queued" {
+			r.Results = append(r.Results[:i], r.Results[i+1:]...)
+			r.getStats(t).MarkedForQueue--
+			return
+		}
+	}
+}
+
 // IncrementScanned increments the scanned count for a media type
 func (r *ProcessingResult) IncrementScanned(t MediaType, count int) {
 	r.getStats(t).Scanned = count
@@ -0,0 +1,13 @@
+package cleanup
+
+import (
+	"os"
+	"testing"
+
+	"github.com/fusionn-air/pkg/logger"
+)
+
+func TestMain(m *testing.M) {
+	logger.Init(false)
+	os.Exit(m.Run())
+}
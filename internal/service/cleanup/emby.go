@@ -0,0 +1,143 @@
+package cleanup
+
+import (
+	"context"
+	"fmt"
+	"strconv"
+
+	"github.com/fusionn-air/internal/client/emby"
+	"github.com/fusionn-air/internal/config"
+	"github.com/fusionn-air/pkg/logger"
+)
+
+// embyServer adapts Emby to the orphan processor. Items are queued by their
+// numeric Emby ID.
+type embyServer struct {
+	client *emby.Client
+}
+
+func (e *embyServer) Name() string { return "Emby" }
+
+func (e *embyServer) Enabled(cfg *config.Config) bool { return cfg.Emby.Enabled }
+
+func (e *embyServer) Types() (series, movies MediaType) {
+	return MediaTypeEmbySeries, MediaTypeEmbyMovie
+}
+
+// Libraries collects series and movies across non-excluded Emby libraries
+func (e *embyServer) Libraries(ctx context.Context, cfg *config.Config, wantSeries, wantMovies bool) (series, movies []orphanItem) {
+	libraries, excludedLibNames := e.resolveLibrariesAndExclusions(ctx, cfg)
+
+	for _, lib := range libraries {
+		if excludedLibNames[lib.Name] {
+			logger.Infof("📚 Skipping excluded library %q (ID: %s)", lib.Name, lib.ItemID)
+			continue
+		}
+
+		switch lib.CollectionType {
+		case "movies":
+			if !wantMovies {
+				logger.Warnf("⚠️  Skipping movie library %q - Radarr data unavailable", lib.Name)
+				continue
+			}
+			items, err := e.client.GetMovies(ctx, lib.ItemID)
+			if err != nil {
+				logger.Errorf("❌ Failed to get movies from library %q: %v", lib.Name, err)
+				continue
+			}
+			logger.Infof("🎬 Found %d movies in library %q", len(items), lib.Name)
+			for _, item := range items {
+				movies = append(movies, embyOrphan(item, lib.Name))
+			}
+
+		case "tvshows":
+			if !wantSeries {
+				logger.Warnf("⚠️  Skipping TV library %q - Sonarr data unavailable", lib.Name)
+				continue
+			}
+			items, err := e.client.GetSeries(ctx, lib.ItemID)
+			if err != nil {
+				logger.Errorf("❌ Failed to get series from library %q: %v", lib.Name, err)
+				continue
+			}
+			logger.Infof("📺 Found %d series in library %q", len(items), lib.Name)
+			for _, item := range items {
+				series = append(series, embyOrphan(item, lib.Name))
+			}
+
+		default:
+			if lib.CollectionType != "" {
+				logger.Debugf("📚 Skipping library %q (unsupported type: %s)", lib.Name, lib.CollectionType)
+			} else {
+				logger.Debugf("📚 Skipping library %q (mixed content not supported)", lib.Name)
+			}
+		}
+	}
+
+	return series, movies
+}
+
+// resolveLibrariesAndExclusions fetches Emby libraries and builds a map of excluded library names.
+// Returns all libraries and a map of excluded names for filtering.
+func (e *embyServer) resolveLibrariesAndExclusions(ctx context.Context, cfg *config.Config) ([]emby.VirtualFolder, map[string]bool) {
+	libraries, err := e.client.GetLibraries(ctx)
+	if err != nil {
+		logger.Warnf("⚠️  Failed to fetch Emby libraries: %v — proceeding without library filtering", err)
+		return nil, nil
+	}
+
+	names := make([]string, len(libraries))
+	for i, lib := range libraries {
+		names[i] = lib.Name
+	}
+
+	return libraries, excludedLibrarySet("Emby", names, cfg.Emby.ExcludedLibraries)
+}
+
+// embyOrphan converts an Emby item; invalid IDs leave queueID 0
+func embyOrphan(item emby.Item, library string) orphanItem {
+	id, _ := strconv.Atoi(item.ID)
+	return orphanItem{
+		queueID: id,
+		itemID:  item.ID,
+		title:   item.Name,
+		tvdbID:  emby.ParseProviderID(item.ProviderIDs, "Tvdb"),
+		tmdbID:  emby.ParseProviderID(item.ProviderIDs, "Tmdb"),
+		imdbID:  item.ProviderIDs.Imdb,
+		subject: embySubject(item, library),
+	}
+}
+
+// SeasonFiles returns the number of episodes with files per season
+func (e *embyServer) SeasonFiles(ctx context.Context, seriesID string) (map[int]int, error) {
+	seasons, err := e.client.GetSeasons(ctx, seriesID)
+	if err != nil {
+		return nil, fmt.Errorf("getting Emby seasons: %w", err)
+	}
+
+	files := make(map[int]int)
+	for _, season := range seasons {
+		seasonNum := season.IndexNumber
+		if seasonNum == 0 {
+			continue
+		}
+
+		episodes, err := e.client.GetEpisodes(ctx, seriesID, season.ID)
+		if err != nil {
+			logger.Warnf("Failed to get Emby episodes for season %d: %v", seasonNum, err)
+			continue
+		}
+
+		for _, ep := range episodes {
+			if ep.LocationType != "Virtual" {
+				files[seasonNum]++
+			}
+		}
+	}
+
+	return files, nil
+}
+
+func (e *embyServer) DeleteItem(ctx context.Context, itemID string) error {
+	return e.client.DeleteItem(ctx, itemID)
+}
@@ -0,0 +1,110 @@
+package cleanup
+
+import (
+	"fmt"
+	"regexp"
+	"strconv"
+	"strings"
+	"sync"
+
+	"github.com/fusionn-air/pkg/logger"
+)
+
+// Exclusion entry prefixes. Entries without a prefix match the exact title.
+const (
+	exclusionTVDB  = "tvdb:"
+	exclusionTMDB  = "tmdb:"
+	exclusionIMDb  = "imdb:"
+	exclusionTag   = "tag:"
+	exclusionGlob  = "glob:"
+	exclusionRegex = "regex:"
+)
+
+// exclusionPatterns caches compiled glob/regex entries (nil when invalid)
+var exclusionPatterns sync.Map
+
+// exclusionSubject is what exclusion entries match against
+type exclusionSubject struct {
+	title  string
+	tvdbID int
+	tmdbID int
+	imdbID string
+	tags   []string // Sonarr/Radarr tag labels
+}
+
+// matchExclusion returns the first exclusion entry matching subject, or ""
+func matchExclusion(exclusions []string, subject exclusionSubject) string {
+	for _, entry := range exclusions {
+		if exclusionMatches(strings.TrimSpace(entry), subject) {
+			return entry
+		}
+	}
+	return ""
+}
+
+// exclusionReason describes which exclusion entry matched
+func exclusionReason(entry string) string {
+	return fmt.Sprintf("in exclusion list (%s)", entry)
+}
+
+func exclusionMatches(entry string, subject exclusionSubject) bool {
+	prefix, value, hasPrefix := strings.Cut(entry, ":")
+	if !hasPrefix {
+		return strings.EqualFold(entry, subject.title)
+	}
+	value = strings.TrimSpace(value)
+
+	switch strings.ToLower(prefix) + ":" {
+	case exclusionTVDB:
+		id, err := strconv.Atoi(value)
+		return err == nil && id > 0 && id == subject.tvdbID
+	case exclusionTMDB:
+		id, err := strconv.Atoi(value)
+		return err == nil && id > 0 && id == subject.tmdbID
+	case exclusionIMDb:
+		return value != "" && strings.EqualFold(value, subject.imdbID)
+	case exclusionTag:
+		return anyEqualFold([]string{value}, subject.tags...)
+	case exclusionGlob:
+		re := compileExclusion(entry, globToRegexp(value))
+		return re != nil && re.MatchString(subject.title)
+	case exclusionRegex:
+		re := compileExclusion(entry, value)
+		return re != nil && re.MatchString(subject.title)
+	default:
+		// Titles may contain colons ("Star Trek: Picard")
+		return strings.EqualFold(entry, subject.title)
+	}
+}
+
+// compileExclusion compiles and caches a pattern, warning once when invalid
+func compileExclusion(entry, pattern string) *regexp.Regexp {
+	if cached, ok := exclusionPatterns.Load(entry); ok {
+		return cached.(*regexp.Regexp)
+	}
+	re, err := regexp.Compile(pattern)
+	if err != nil {
+		logger.Warnf("⚠️  Invalid exclusion %q: %v", entry, err)
+		re = nil
+	}
+	exclusionPatterns.Store(entry, re)
+	return re
+}
+
+// globToRegexp converts a case-insensitive title glob (* and ?) to a regexp
+func globToRegexp(glob string) string {
+	pattern := regexp.QuoteMeta(glob)
+	pattern = strings.ReplaceAll(pattern, `\*`, `.*`)
+	pattern = strings.ReplaceAll(pattern, `\?`, `.`)
+	return "(?i)^" + pattern + "$"
+}
+
+// hasTagExclusions checks if any exclusion needs Sonarr/Radarr tag labels
+func hasTagExclusions(exclusions []string) bool {
+	for _, entry := range exclusions {
+		if strings.HasPrefix(strings.ToLower(strings.TrimSpace(entry)), exclusionTag) {
+			return true
+		}
+	}
+	return false
+}
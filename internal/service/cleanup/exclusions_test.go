@@ -0,0 +1,78 @@
+package cleanup
+
+import "testing"
+
+func TestMatchExclusion(t *testing.T) {
+	subject := exclusionSubject{
+		title:  "Star Trek: Picard",
+		tvdbID: 364093,
+		tmdbID: 85949,
+		imdbID: "tt8806524",
+		tags:   []string{"keep", "4k"},
+	}
+
+	tests := []struct {
+		name  string
+		entry string
+		want  bool
+	}{
+		{"exact title", "Star Trek: Picard", true},
+		{"title is case-insensitive", "star trek: picard", true},
+		{"title with surrounding spaces", "  Star Trek: Picard ", true},
+		{"other title", "Star Trek", false},
+		{"tvdb", "tvdb:364093", true},
+		{"tvdb with space", "tvdb: 364093", true},
+		{"prefix is case-insensitive", "TVDB:364093", true},
+		{"other tvdb", "tvdb:1", false},
+		{"invalid tvdb", "tvdb:abc", false},
+		{"tmdb", "tmdb:85949", true},
+		{"tvdb ID as tmdb", "tmdb:364093", false},
+		{"imdb", "imdb:TT8806524", true},
+		{"empty imdb", "imdb:", false},
+		{"tag", "tag:Keep", true},
+		{"missing tag", "tag:archive", false},
+		{"glob", "glob:star trek*", true},
+		{"glob single char", "glob:Star Trek? Picard", true},
+		{"glob must match whole title", "glob:Trek*", false},
+		{"glob escapes regex chars", "glob:Star Trek. Picard", false},
+		{"regex", "regex:^Star Trek", true},
+		{"regex is case-sensitive", "regex:^star trek", false},
+		{"invalid regex", "regex:(", false},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got := matchExclusion([]string{tt.entry}, subject)
+			if (got != "") != tt.want {
+				t.Errorf("matchExclusion(%q) = %q, want match %v", tt.entry, got, tt.want)
+			}
+		})
+	}
+}
+
+func TestMatchExclusionUnknownPrefix(t *testing.T) {
+	subject := exclusionSubject{title: "Mission: Impossible"}
+	if got := matchExclusion([]string{"tvdb:1", "Mission: Impossible"}, subject); got != "Mission: Impossible" {
+		t.Errorf("matchExclusion() = %q, want the title entry", got)
+	}
+}
+
+func TestHasTagExclusions(t *testing.T) {
+	tests := []struct {
+		name       string
+		exclusions []string
+		want       bool
+	}{
+		{"none", nil, false},
+		{"titles and IDs", []string{"Breaking Bad", "tvdb:81189"}, false},
+		{"tag", []string{"Breaking Bad", " TAG:keep"}, true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := hasTagExclusions(tt.exclusions); got != tt.want {
+				t.Errorf("hasTagExclusions(%v) = %v, want %v", tt.exclusions, got, tt.want)
+			}
+		})
+	}
+}
@@ -0,0 +1,83 @@
+package cleanup
+
+import (
+	"context"
+	"strings"
+
+	"github.com/fusionn-air/internal/client/trakt"
+	"github.com/fusionn-air/internal/config"
+	"github.com/fusionn-air/pkg/logger"
+)
+
+// Watch history providers
+const (
+	HistoryProviderTrakt = "trakt"
+	HistoryProviderEmby  = "emby"
+	HistoryProviderPlex  = "plex"
+)
+
+// WatchHistory supplies the watch state cleanup uses to decide whether media
+// is fully watched. Results use Trakt's shapes so every provider plugs into
+// the same season/episode comparisons.
+type WatchHistory interface {
+	// Name is a human-readable provider name for logs and reasons
+	Name() string
+	GetWatchedShows(ctx context.Context) ([]trakt.WatchedShow, error)
+	GetWatchedMovies(ctx context.Context) ([]trakt.WatchedMovie, error)
+	// GetShowProgress and GetShowSeasons accept a show returned by GetWatchedShows
+	GetShowProgress(ctx context.Context, show trakt.Show) (*trakt.ShowProgress, error)
+	GetShowSeasons(ctx context.Context, show trakt.Show) ([]trakt.SeasonSummary, error)
+}
+
+// traktHistory reads watch state from the authenticated Trakt account
+type traktHistory struct {
+	client *trakt.Client
+}
+
+func (h *traktHistory) Name() string { return "Trakt" }
+
+func (h *traktHistory) GetWatchedShows(ctx context.Context) ([]trakt.WatchedShow, error) {
+	return h.client.GetWatchedShows(ctx)
+}
+
+func (h *traktHistory) GetWatchedMovies(ctx context.Context) ([]trakt.WatchedMovie, error) {
+	return h.client.GetWatchedMovies(ctx)
+}
+
+func (h *traktHistory) GetShowProgress(ctx context.Context, show trakt.Show) (*trakt.ShowProgress, error) {
+	return h.client.GetShowProgress(ctx, show.IDs.Trakt)
+}
+
+func (h *traktHistory) GetShowSeasons(ctx context.Context, show trakt.Show) ([]trakt.SeasonSummary, error) {
+	return h.client.GetShowSeasons(ctx, show.IDs.Trakt)
+}
+
+// watchHistory returns the provider selected in config for this run.
+// Falls back to Trakt when the selected provider isn't available.
+func (s *Service) watchHistory(cfg *config.Config) WatchHistory {
+	provider := strings.ToLower(cfg.Cleanup.WatchHistory.Provider)
+
+	switch provider {
+	case "", HistoryProviderTrakt:
+		return &traktHistory{client: s.trakt}
+	case HistoryProviderEmby:
+		if s.emby == nil {
+			logger.Warn("⚠️  Watch history provider is emby but Emby is not configured — using Trakt")
+			return &traktHistory{client: s.trakt}
+		}
+		if cfg.Cleanup.WatchHistory.EmbyUser == "" {
+			logger.Warn("⚠️  Watch history provider is emby but cleanup.watch_history.emby_user is empty — using Trakt")
+			return &traktHistory{client: s.trakt}
+		}
+		return newEmbyHistory(s.emby, cfg.Cleanup.WatchHistory.EmbyUser)
+	case HistoryProviderPlex:
+		if s.plex == nil {
+			logger.Warn("⚠️  Watch history provider is plex but Plex is not configured — using Trakt")
+			return &traktHistory{client: s.trakt}
+		}
+		return newPlexHistory(s.plex)
+	default:
+		logger.Warnf("⚠️  Unknown watch history provider %q — using Trakt", provider)
+		return &traktHistory{client: s.trakt}
+	}
+}
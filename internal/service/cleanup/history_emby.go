@@ -0,0 +1,303 @@
+package cleanup
+
+import (
+	"context"
+	"fmt"
+	"sort"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/fusionn-air/internal/client/emby"
+	"github.com/fusionn-air/internal/client/trakt"
+)
+
+// embyHistory reads watch state from an Emby user's played flags.
+// Series are matched by TVDB ID; episodes Emby doesn't know about (no
+// metadata, missing episodes hidden) can't count towards "aired".
+type embyHistory struct {
+	client *emby.Client
+	user   string // Name or ID from config
+
+	mu           sync.Mutex
+	userID       string
+	seriesByTvdb map[int]string         // TVDB → Emby series ID, filled by GetWatchedShows
+	episodes     map[string][]emby.Item // Emby series ID → episodes with user data
+}
+
+func newEmbyHistory(client *emby.Client, user string) *embyHistory {
+	return &embyHistory{
+		client:       client,
+		user:         user,
+		seriesByTvdb: make(map[int]string),
+		episodes:     make(map[string][]emby.Item),
+	}
+}
+
+func (h *embyHistory) Name() string { return "Emby" }
+
+// resolveUser maps the configured user name or ID to an Emby user ID
+func (h *embyHistory) resolveUser(ctx context.Context) (string, error) {
+	h.mu.Lock()
+	defer h.mu.Unlock()
+
+	if h.userID != "" {
+		return h.userID, nil
+	}
+
+	users, err := h.client.GetUsers(ctx)
+	if err != nil {
+		return "", err
+	}
+
+	for _, u := range users {
+		if u.ID == h.user || strings.EqualFold(u.Name, h.user) {
+			h.userID = u.ID
+			return h.userID, nil
+		}
+	}
+
+	return "", fmt.Errorf("emby user %q not found", h.user)
+}
+
+func (h *embyHistory) GetWatchedShows(ctx context.Context) ([]trakt.WatchedShow, error) {
+	userID, err := h.resolveUser(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	played, err := h.client.GetPlayedItems(ctx, userID, "Episode")
+	if err != nil {
+		return nil, err
+	}
+
+	allSeries, err := h.client.GetAllSeries(ctx)
+	if err != nil {
+		return nil, err
+	}
+	seriesByID := make(map[string]emby.Item, len(allSeries))
+	for _, ser := range allSeries {
+		seriesByID[ser.ID] = ser
+	}
+
+	showsBySeriesID := make(map[string]*trakt.WatchedShow)
+	var order []string
+
+	for _, ep := range played {
+		ser, ok := seriesByID[ep.SeriesID]
+		if !ok {
+			continue
+		}
+
+		show, exists := showsBySeriesID[ep.SeriesID]
+		if !exists {
+			show = &trakt.WatchedShow{
+				Show: trakt.Show{
+					Title: ser.Name,
+					Year:  ser.ProductionYear,
+					IDs: trakt.IDs{
+						TVDB: emby.ParseProviderID(ser.ProviderIDs, "Tvdb"),
+						TMDB: emby.ParseProviderID(ser.ProviderIDs, "Tmdb"),
+						IMDB: ser.ProviderIDs.Imdb,
+					},
+				},
+			}
+			showsBySeriesID[ep.SeriesID] = show
+			order = append(order, ep.SeriesID)
+		}
+
+		watchedEp := trakt.WatchedEpisode{Number: ep.IndexNumber, Plays: 1}
+		if ep.UserData != nil {
+			if ep.UserData.PlayCount > 0 {
+				watchedEp.Plays = ep.UserData.PlayCount
+			}
+			if ep.UserData.LastPlayedDate != nil {
+				watchedEp.LastWatchedAt = *ep.UserData.LastPlayedDate
+			}
+		}
+
+		show.Plays += watchedEp.Plays
+		if watchedEp.LastWatchedAt.After(show.LastWatchedAt) {
+			show.LastWatchedAt = watchedEp.LastWatchedAt
+		}
+		show.Seasons = appendWatchedEpisode(show.Seasons, ep.ParentIndexNumber, watchedEp)
+	}
+
+	h.mu.Lock()
+	shows := make([]trakt.WatchedShow, 0, len(order))
+	for _, id := range order {
+		show := showsBySeriesID[id]
+		if show.Show.IDs.TVDB > 0 {
+			h.seriesByTvdb[show.Show.IDs.TVDB] = id
+		}
+		shows = append(shows, *show)
+	}
+	h.mu.Unlock()
+
+	return shows, nil
+}
+
+func appendWatchedEpisode(seasons []trakt.WatchedSeason, seasonNum int, ep trakt.WatchedEpisode) []trakt.WatchedSeason {
+	for i := range seasons {
+		if seasons[i].Number == seasonNum {
+			seasons[i].Episodes = append(seasons[i].Episodes, ep)
+			return seasons
+		}
+	}
+	return append(seasons, trakt.WatchedSeason{Number: seasonNum, Episodes: []trakt.WatchedEpisode{ep}})
+}
+
+func (h *embyHistory) GetWatchedMovies(ctx context.Context) ([]trakt.WatchedMovie, error) {
+	userID, err := h.resolveUser(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	played, err := h.client.GetPlayedItems(ctx, userID, "Movie")
+	if err != nil {
+		return nil, err
+	}
+
+	movies := make([]trakt.WatchedMovie, 0, len(played))
+	for _, item := range played {
+		movie := trakt.WatchedMovie{
+			Plays: 1,
+			Movie: trakt.Movie{
+				Title: item.Name,
+				Year:  item.ProductionYear,
+				IDs: trakt.IDs{
+					TMDB: emby.ParseProviderID(item.ProviderIDs, "Tmdb"),
+					IMDB: item.ProviderIDs.Imdb,
+				},
+			},
+		}
+		if item.UserData != nil {
+			if item.UserData.PlayCount > 0 {
+				movie.Plays = item.UserData.PlayCount
+			}
+			if item.UserData.LastPlayedDate != nil {
+				movie.LastWatchedAt = *item.UserData.LastPlayedDate
+			}
+		}
+		movies = append(movies, movie)
+	}
+
+	return movies, nil
+}
+
+// seriesEpisodes returns the user's episode state for a show from GetWatchedShows
+func (h *embyHistory) seriesEpisodes(ctx context.Context, show trakt.Show) ([]emby.Item, error) {
+	h.mu.Lock()
+	seriesID, ok := h.seriesByTvdb[show.IDs.TVDB]
+	cached, hasCached := h.episodes[seriesID]
+	h.mu.Unlock()
+
+	if !ok {
+		return nil, fmt.Errorf("series %q not found in Emby watch history", show.Title)
+	}
+	if hasCached {
+		return cached, nil
+	}
+
+	userID, err := h.resolveUser(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	episodes, err := h.client.GetUserEpisodes(ctx, seriesID, userID)
+	if err != nil {
+		return nil, err
+	}
+
+	sort.Slice(episodes, func(i, j int) bool {
+		if episodes[i].ParentIndexNumber != episodes[j].ParentIndexNumber {
+			return episodes[i].ParentIndexNumber < episodes[j].ParentIndexNumber
+		}
+		return episodes[i].IndexNumber < episodes[j].IndexNumber
+	})
+
+	h.mu.Lock()
+	h.episodes[seriesID] = episodes
+	h.mu.Unlock()
+
+	return episodes, nil
+}
+
+// hasAired treats episodes with a file as aired even without a premiere date
+func hasAired(ep emby.Item, now time.Time) bool {
+	if ep.PremiereDate != nil {
+		return !ep.PremiereDate.After(now)
+	}
+	return ep.LocationType != "Virtual"
+}
+
+func (h *embyHistory) GetShowProgress(ctx context.Context, show trakt.Show) (*trakt.ShowProgress, error) {
+	episodes, err := h.seriesEpisodes(ctx, show)
+	if err != nil {
+		return nil, err
+	}
+
+	now := time.Now()
+	progress := &trakt.ShowProgress{}
+	seasonIdx := make(map[int]int)
+
+	for _, ep := range episodes {
+		if !hasAired(ep, now) {
+			continue
+		}
+
+		idx, ok := seasonIdx[ep.ParentIndexNumber]
+		if !ok {
+			progress.Seasons = append(progress.Seasons, trakt.SeasonProgress{Number: ep.ParentIndexNumber})
+			idx = len(progress.Seasons) - 1
+			seasonIdx[ep.ParentIndexNumber] = idx
+		}
+		sp := &progress.Seasons[idx]
+
+		played := ep.UserData != nil && ep.UserData.Played
+		epProgress := trakt.EpisodeProgress{Number: ep.IndexNumber, Completed: played}
+		if played && ep.UserData.LastPlayedDate != nil {
+			epProgress.LastWatchedAt = ep.UserData.LastPlayedDate
+			if progress.LastWatchedAt == nil || ep.UserData.LastPlayedDate.After(*progress.LastWatchedAt) {
+				progress.LastWatchedAt = ep.UserData.LastPlayedDate
+			}
+		}
+		sp.Episodes = append(sp.Episodes, epProgress)
+		sp.Aired++
+		progress.Aired++
+		if played {
+			sp.Completed++
+			progress.Completed++
+		} else if progress.NextEpisode == nil && ep.ParentIndexNumber > 0 {
+			progress.NextEpisode = &trakt.Episode{Season: ep.ParentIndexNumber, Number: ep.IndexNumber, Title: ep.Name}
+		}
+	}
+
+	return progress, nil
+}
+
+func (h *embyHistory) GetShowSeasons(ctx context.Context, show trakt.Show) ([]trakt.SeasonSummary, error) {
+	episodes, err := h.seriesEpisodes(ctx, show)
+	if err != nil {
+		return nil, err
+	}
+
+	now := time.Now()
+	var seasons []trakt.SeasonSummary
+	seasonIdx := make(map[int]int)
+
+	for _, ep := range episodes {
+		idx, ok := seasonIdx[ep.ParentIndexNumber]
+		if !ok {
+			seasons = append(seasons, trakt.SeasonSummary{Number: ep.ParentIndexNumber})
+			idx = len(seasons) - 1
+			seasonIdx[ep.ParentIndexNumber] = idx
+		}
+		seasons[idx].EpisodeCount++
+		if hasAired(ep, now) {
+			seasons[idx].AiredEpisodes++
+		}
+	}
+
+	return seasons, nil
+}
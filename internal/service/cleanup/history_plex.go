@@ -0,0 +1,258 @@
+package cleanup
+
+import (
+	"context"
+	"fmt"
+	"sort"
+	"sync"
+	"time"
+
+	"github.com/fusionn-air/internal/client/plex"
+	"github.com/fusionn-air/internal/client/trakt"
+)
+
+// plexHistory reads watch state from the Plex token owner's viewed flags.
+// Shows are matched by TVDB ID; Plex only lists episodes in the library, so
+// missing episodes can't count towards "aired".
+type plexHistory struct {
+	client *plex.Client
+
+	mu          sync.Mutex
+	showsByTvdb map[int]string             // TVDB → show rating key, filled by GetWatchedShows
+	episodes    map[string][]plex.Metadata // Show rating key → episodes with watch state
+}
+
+func newPlexHistory(client *plex.Client) *plexHistory {
+	return &plexHistory{
+		client:      client,
+		showsByTvdb: make(map[int]string),
+		episodes:    make(map[string][]plex.Metadata),
+	}
+}
+
+func (h *plexHistory) Name() string { return "Plex" }
+
+// sectionItems returns the items of every library section of a type
+func (h *plexHistory) sectionItems(ctx context.Context, sectionType string) ([]plex.Metadata, error) {
+	sections, err := h.client.GetSections(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	var items []plex.Metadata
+	for _, section := range sections {
+		if section.Type != sectionType {
+			continue
+		}
+		sectionItems, err := h.client.GetItems(ctx, section.Key)
+		if err != nil {
+			return nil, fmt.Errorf("plex library %q: %w", section.Title, err)
+		}
+		items = append(items, sectionItems...)
+	}
+	return items, nil
+}
+
+// viewedAt converts Plex's last viewed timestamp; zero if never viewed
+func viewedAt(item plex.Metadata) time.Time {
+	if item.LastViewedAt == 0 {
+		return time.Time{}
+	}
+	return time.Unix(item.LastViewedAt, 0)
+}
+
+func (h *plexHistory) GetWatchedShows(ctx context.Context) ([]trakt.WatchedShow, error) {
+	items, err := h.sectionItems(ctx, plex.SectionTypeShow)
+	if err != nil {
+		return nil, err
+	}
+
+	var shows []trakt.WatchedShow
+	for _, item := range items {
+		if item.ViewedLeafCount == 0 {
+			continue
+		}
+
+		guids := item.ExternalGUIDs()
+		show := trakt.WatchedShow{
+			Show: trakt.Show{
+				Title: item.Title,
+				Year:  item.Year,
+				IDs: trakt.IDs{
+					TVDB: plex.ParseGUID(guids, "tvdb"),
+					TMDB: plex.ParseGUID(guids, "tmdb"),
+					IMDB: plex.GUIDValue(guids, "imdb"),
+				},
+			},
+		}
+		if show.Show.IDs.TVDB == 0 {
+			continue
+		}
+
+		h.mu.Lock()
+		h.showsByTvdb[show.Show.IDs.TVDB] = item.RatingKey
+		h.mu.Unlock()
+
+		episodes, err := h.showEpisodes(ctx, show.Show)
+		if err != nil {
+			return nil, err
+		}
+		for _, ep := range episodes {
+			if ep.ViewCount == 0 {
+				continue
+			}
+			watchedEp := trakt.WatchedEpisode{Number: ep.Index, Plays: ep.ViewCount, LastWatchedAt: viewedAt(ep)}
+			show.Plays += watchedEp.Plays
+			if watchedEp.LastWatchedAt.After(show.LastWatchedAt) {
+				show.LastWatchedAt = watchedEp.LastWatchedAt
+			}
+			show.Seasons = appendWatchedEpisode(show.Seasons, ep.ParentIndex, watchedEp)
+		}
+		shows = append(shows, show)
+	}
+
+	return shows, nil
+}
+
+func (h *plexHistory) GetWatchedMovies(ctx context.Context) ([]trakt.WatchedMovie, error) {
+	items, err := h.sectionItems(ctx, plex.SectionTypeMovie)
+	if err != nil {
+		return nil, err
+	}
+
+	var movies []trakt.WatchedMovie
+	for _, item := range items {
+		if item.ViewCount == 0 {
+			continue
+		}
+		guids := item.ExternalGUIDs()
+		movies = append(movies, trakt.WatchedMovie{
+			Plays:         item.ViewCount,
+			LastWatchedAt: viewedAt(item),
+			Movie: trakt.Movie{
+				Title: item.Title,
+				Year:  item.Year,
+				IDs: trakt.IDs{
+					TMDB: plex.ParseGUID(guids, "tmdb"),
+					IMDB: plex.GUIDValue(guids, "imdb"),
+				},
+			},
+		})
+	}
+
+	return movies, nil
+}
+
+// showEpisodes returns the episodes of a show from GetWatchedShows
+func (h *plexHistory) showEpisodes(ctx context.Context, show trakt.Show) ([]plex.Metadata, error) {
+	h.mu.Lock()
+	ratingKey, ok := h.showsByTvdb[show.IDs.TVDB]
+	cached, hasCached := h.episodes[ratingKey]
+	h.mu.Unlock()
+
+	if !ok {
+		return nil, fmt.Errorf("show %q not found in Plex watch history", show.Title)
+	}
+	if hasCached {
+		return cached, nil
+	}
+
+	episodes, err := h.client.GetAllEpisodes(ctx, ratingKey)
+	if err != nil {
+		return nil, err
+	}
+
+	sort.Slice(episodes, func(i, j int) bool {
+		if episodes[i].ParentIndex != episodes[j].ParentIndex {
+			return episodes[i].ParentIndex < episodes[j].ParentIndex
+		}
+		return episodes[i].Index < episodes[j].Index
+	})
+
+	h.mu.Lock()
+	h.episodes[ratingKey] = episodes
+	h.mu.Unlock()
+
+	return episodes, nil
+}
+
+// plexEpisodeAired treats episodes without an air date as aired; they're in
+// the library, so they have a file
+func plexEpisodeAired(ep plex.Metadata, now time.Time) bool {
+	aired, err := time.Parse(time.DateOnly, ep.OriginallyAvailableAt)
+	if err != nil {
+		return true
+	}
+	return !aired.After(now)
+}
+
+func (h *plexHistory) GetShowProgress(ctx context.Context, show trakt.Show) (*trakt.ShowProgress, error) {
+	episodes, err := h.showEpisodes(ctx, show)
+	if err != nil {
+		return nil, err
+	}
+
+	now := time.Now()
+	progress := &trakt.ShowProgress{}
+	seasonIdx := make(map[int]int)
+
+	for _, ep := range episodes {
+		if !plexEpisodeAired(ep, now) {
+			continue
+		}
+
+		idx, ok := seasonIdx[ep.ParentIndex]
+		if !ok {
+			progress.Seasons = append(progress.Seasons, trakt.SeasonProgress{Number: ep.ParentIndex})
+			idx = len(progress.Seasons) - 1
+			seasonIdx[ep.ParentIndex] = idx
+		}
+		sp := &progress.Seasons[idx]
+
+		played := ep.ViewCount > 0
+		epProgress := trakt.EpisodeProgress{Number: ep.Index, Completed: played}
+		if last := viewedAt(ep); played && !last.IsZero() {
+			epProgress.LastWatchedAt = &last
+			if progress.LastWatchedAt == nil || last.After(*progress.LastWatchedAt) {
+				progress.LastWatchedAt = &last
+			}
+		}
+		sp.Episodes = append(sp.Episodes, epProgress)
+		sp.Aired++
+		progress.Aired++
+		if played {
+			sp.Completed++
+			progress.Completed++
+		} else if progress.NextEpisode == nil && ep.ParentIndex > 0 {
+			progress.NextEpisode = &trakt.Episode{Season: ep.ParentIndex, Number: ep.Index, Title: ep.Title}
+		}
+	}
+
+	return progress, nil
+}
+
+func (h *plexHistory) GetShowSeasons(ctx context.Context, show trakt.Show) ([]trakt.SeasonSummary, error) {
+	episodes, err := h.showEpisodes(ctx, show)
+	if err != nil {
+		return nil, err
+	}
+
+	now := time.Now()
+	var seasons []trakt.SeasonSummary
+	seasonIdx := make(map[int]int)
+
+	for _, ep := range episodes {
+		idx, ok := seasonIdx[ep.ParentIndex]
+		if !ok {
+			seasons = append(seasons, trakt.SeasonSummary{Number: ep.ParentIndex})
+			idx = len(seasons) - 1
+			seasonIdx[ep.ParentIndex] = idx
+		}
+		seasons[idx].EpisodeCount++
+		if plexEpisodeAired(ep, now) {
+			seasons[idx].AiredEpisodes++
+		}
+	}
+
+	return seasons, nil
+}
@@ -0,0 +1,132 @@
+package cleanup
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"github.com/fusionn-air/internal/client/plex"
+	"github.com/fusionn-air/internal/config"
+)
+
+// plexFixtures are canned Plex responses keyed by request path
+var plexFixtures = map[string]string{
+	"/library/sections": `{"MediaContainer": {"Directory": [
+		{"key": "1", "title": "TV", "type": "show"},
+		{"key": "2", "title": "Movies", "type": "movie"},
+		{"key": "3", "title": "Music", "type": "artist"}
+	]}}`,
+	"/library/sections/1/all": `{"MediaContainer": {"Metadata": [
+		{"ratingKey": "10", "title": "Breaking Bad", "year": 2008, "leafCount": 3, "viewedLeafCount": 2,
+		 "Guid": [{"id": "tvdb://81189"}, {"id": "imdb://tt0903747"}]},
+		{"ratingKey": "11", "title": "Unwatched", "leafCount": 5, "viewedLeafCount": 0, "Guid": [{"id": "tvdb://1"}]},
+		{"ratingKey": "12", "title": "Legacy", "leafCount": 1, "viewedLeafCount": 1,
+		 "guid": "com.plexapp.agents.thetvdb://79168?lang=en"}
+	]}}`,
+	"/library/metadata/10/allLeaves": `{"MediaContainer": {"Metadata": [
+		{"title": "Grey Matter", "parentIndex": 1, "index": 2, "viewCount": 1, "lastViewedAt": 1700000100, "originallyAvailableAt": "2008-01-27"},
+		{"title": "Pilot", "parentIndex": 1, "index": 1, "viewCount": 2, "lastViewedAt": 1700000000, "originallyAvailableAt": "2008-01-20"},
+		{"title": "Seven Thirty-Seven", "parentIndex": 2, "index": 1, "viewCount": 0, "originallyAvailableAt": "2009-03-08"},
+		{"title": "Future", "parentIndex": 3, "index": 1, "viewCount": 0, "originallyAvailableAt": "2999-01-01"}
+	]}}`,
+	"/library/metadata/12/allLeaves": `{"MediaContainer": {"Metadata": [
+		{"title": "The One Where", "parentIndex": 1, "index": 1, "viewCount": 1, "lastViewedAt": 1700000000}
+	]}}`,
+	"/library/sections/2/all": `{"MediaContainer": {"Metadata": [
+		{"ratingKey": "20", "title": "The Matrix", "year": 1999, "viewCount": 3, "lastViewedAt": 1700000000,
+		 "Guid": [{"id": "tmdb://603"}, {"id": "imdb://tt0133093"}]},
+		{"ratingKey": "21", "title": "Unwatched Movie", "viewCount": 0, "Guid": [{"id": "tmdb://604"}]}
+	]}}`,
+}
+
+func newTestPlexHistory(t *testing.T) *plexHistory {
+	t.Helper()
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		body, ok := plexFixtures[r.URL.Path]
+		if !ok {
+			http.NotFound(w, r)
+			return
+		}
+		w.Header().Set("Content-Type", "application/json")
+		_, _ = w.Write([]byte(body))
+	}))
+	t.Cleanup(srv.Close)
+	return newPlexHistory(plex.NewClient(config.PlexConfig{BaseURL: srv.URL, Token: "token"}))
+}
+
+func TestPlexHistoryWatchedShows(t *testing.T) {
+	h := newTestPlexHistory(t)
+	ctx := context.Background()
+
+	shows, err := h.GetWatchedShows(ctx)
+	if err != nil {
+		t.Fatalf("GetWatchedShows() error = %v", err)
+	}
+	if len(shows) != 2 {
+		t.Fatalf("GetWatchedShows() returned %d shows, want 2", len(shows))
+	}
+
+	bb := shows[0]
+	if bb.Show.IDs.TVDB != 81189 || bb.Show.IDs.IMDB != "tt0903747" {
+		t.Errorf("show IDs = %+v, want TVDB 81189, IMDb tt0903747", bb.Show.IDs)
+	}
+	if bb.Plays != 3 {
+		t.Errorf("plays = %d, want 3", bb.Plays)
+	}
+	if want := time.Unix(1700000100, 0); !bb.LastWatchedAt.Equal(want) {
+		t.Errorf("last watched = %v, want %v", bb.LastWatchedAt, want)
+	}
+	if len(bb.Seasons) != 1 || bb.Seasons[0].Number != 1 || len(bb.Seasons[0].Episodes) != 2 {
+		t.Errorf("seasons = %+v, want S01 with 2 episodes", bb.Seasons)
+	}
+	if shows[1].Show.IDs.TVDB != 79168 {
+		t.Errorf("legacy agent show TVDB = %d, want 79168", shows[1].Show.IDs.TVDB)
+	}
+
+	progress, err := h.GetShowProgress(ctx, bb.Show)
+	if err != nil {
+		t.Fatalf("GetShowProgress() error = %v", err)
+	}
+	tests := []struct {
+		name      string
+		got, want int
+	}{
+		{"aired", progress.Aired, 3},
+		{"completed", progress.Completed, 2},
+		{"seasons", len(progress.Seasons), 2},
+		{"S01 completed", progress.Seasons[0].Completed, 2},
+		{"S02 aired", progress.Seasons[1].Aired, 1},
+	}
+	for _, tt := range tests {
+		if tt.got != tt.want {
+			t.Errorf("progress %s = %d, want %d", tt.name, tt.got, tt.want)
+		}
+	}
+	if progress.NextEpisode == nil || progress.NextEpisode.Season != 2 || progress.NextEpisode.Number != 1 {
+		t.Errorf("next episode = %+v, want S02E01", progress.NextEpisode)
+	}
+
+	seasons, err := h.GetShowSeasons(ctx, bb.Show)
+	if err != nil {
+		t.Fatalf("GetShowSeasons() error = %v", err)
+	}
+	if len(seasons) != 3 || seasons[2].EpisodeCount != 1 || seasons[2].AiredEpisodes != 0 {
+		t.Errorf("seasons = %+v, want 3 with an unaired S03", seasons)
+	}
+}
+
+func TestPlexHistoryWatchedMovies(t *testing.T) {
+	movies, err := newTestPlexHistory(t).GetWatchedMovies(context.Background())
+	if err != nil {
+		t.Fatalf("GetWatchedMovies() error = %v", err)
+	}
+	if len(movies) != 1 {
+		t.Fatalf("GetWatchedMovies() returned %d movies, want 1", len(movies))
+	}
+	m := movies[0]
+	if m.Movie.IDs.TMDB != 603 || m.Movie.IDs.IMDB != "tt0133093" || m.Plays != 3 {
+		t.Errorf("movie = %+v, want TMDB 603, IMDb tt0133093, 3 plays", m)
+	}
+}
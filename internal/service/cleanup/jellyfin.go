@@ -0,0 +1,145 @@
+package cleanup
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/fusionn-air/internal/client/jellyfin"
+	"github.com/fusionn-air/internal/config"
+	"github.com/fusionn-air/pkg/logger"
+)
+
+// jellyfinServer adapts Jellyfin to the orphan processor. Jellyfin item IDs
+// are GUIDs, so items are queued by TVDB/TMDB ID instead.
+type jellyfinServer struct {
+	client *jellyfin.Client
+}
+
+func (j *jellyfinServer) Name() string { return "Jellyfin" }
+
+func (j *jellyfinServer) Enabled(cfg *config.Config) bool { return cfg.Jellyfin.Enabled }
+
+func (j *jellyfinServer) Types() (series, movies MediaType) {
+	return MediaTypeJellyfinSeries, MediaTypeJellyfinMovie
+}
+
+// Libraries collects series and movies across non-excluded Jellyfin libraries
+func (j *jellyfinServer) Libraries(ctx context.Context, cfg *config.Config, wantSeries, wantMovies bool) (series, movies []orphanItem) {
+	libraries, excludedLibNames := j.resolveLibraries(ctx, cfg)
+
+	for _, lib := range libraries {
+		if excludedLibNames[lib.Name] {
+			logger.Infof("📚 Skipping excluded Jellyfin library %q (ID: %s)", lib.Name, lib.ItemID)
+			continue
+		}
+
+		switch lib.CollectionType {
+		case "movies":
+			if !wantMovies {
+				logger.Warnf("⚠️  Skipping Jellyfin movie library %q - Radarr data unavailable", lib.Name)
+				continue
+			}
+			items, err := j.client.GetMovies(ctx, lib.ItemID)
+			if err != nil {
+				logger.Errorf("❌ Failed to get movies from Jellyfin library %q: %v", lib.Name, err)
+				continue
+			}
+			logger.Infof("🎬 Found %d movies in Jellyfin library %q", len(items), lib.Name)
+			for _, item := range items {
+				movies = append(movies, jellyfinOrphan(item, lib.Name, false))
+			}
+
+		case "tvshows":
+			if !wantSeries {
+				logger.Warnf("⚠️  Skipping Jellyfin TV library %q - Sonarr data unavailable", lib.Name)
+				continue
+			}
+			items, err := j.client.GetSeries(ctx, lib.ItemID)
+			if err != nil {
+				logger.Errorf("❌ Failed to get series from Jellyfin library %q: %v", lib.Name, err)
+				continue
+			}
+			logger.Infof("📺 Found %d series in Jellyfin library %q", len(items), lib.Name)
+			for _, item := range items {
+				series = append(series, jellyfinOrphan(item, lib.Name, true))
+			}
+
+		default:
+			if lib.CollectionType != "" {
+				logger.Debugf("📚 Skipping Jellyfin library %q (unsupported type: %s)", lib.Name, lib.CollectionType)
+			} else {
+				logger.Debugf("📚 Skipping Jellyfin library %q (mixed content not supported)", lib.Name)
+			}
+		}
+	}
+
+	return series, movies
+}
+
+// resolveLibraries fetches Jellyfin libraries and builds a map of excluded library names.
+func (j *jellyfinServer) resolveLibraries(ctx context.Context, cfg *config.Config) ([]jellyfin.VirtualFolder, map[string]bool) {
+	libraries, err := j.client.GetLibraries(ctx)
+	if err != nil {
+		logger.Warnf("⚠️  Failed to fetch Jellyfin libraries: %v — proceeding without library filtering", err)
+		return nil, nil
+	}
+
+	names := make([]string, len(libraries))
+	for i, lib := range libraries {
+		names[i] = lib.Name
+	}
+
+	return libraries, excludedLibrarySet("Jellyfin", names, cfg.Jellyfin.ExcludedLibraries)
+}
+
+// jellyfinOrphan converts a Jellyfin item, keyed by TVDB ID for series and
+// TMDB ID for movies
+func jellyfinOrphan(item jellyfin.Item, library string, series bool) orphanItem {
+	orphan := orphanItem{
+		itemID:  item.ID,
+		title:   item.Name,
+		tvdbID:  jellyfin.ParseProviderID(item.ProviderIDs, "Tvdb"),
+		tmdbID:  jellyfin.ParseProviderID(item.ProviderIDs, "Tmdb"),
+		imdbID:  item.ProviderIDs.Imdb,
+		subject: jellyfinSubject(item, library),
+	}
+	orphan.queueID = orphan.tmdbID
+	if series {
+		orphan.queueID = orphan.tvdbID
+	}
+	return orphan
+}
+
+// SeasonFiles returns the number of episodes with files per season
+func (j *jellyfinServer) SeasonFiles(ctx context.Context, seriesID string) (map[int]int, error) {
+	seasons, err := j.client.GetSeasons(ctx, seriesID)
+	if err != nil {
+		return nil, fmt.Errorf("getting Jellyfin seasons: %w", err)
+	}
+
+	files := make(map[int]int)
+	for _, season := range seasons {
+		seasonNum := season.IndexNumber
+		if seasonNum == 0 {
+			continue
+		}
+
+		episodes, err := j.client.GetEpisodes(ctx, seriesID, season.ID)
+		if err != nil {
+			logger.Warnf("Failed to get Jellyfin episodes for season %d: %v", seasonNum, err)
+			continue
+		}
+
+		for _, ep := range episodes {
+			if ep.LocationType != "Virtual" {
+				files[seasonNum]++
+			}
+		}
+	}
+
+	return files, nil
+}
+
+func (j *jellyfinServer) DeleteItem(ctx context.Context, itemID string) error {
+	return j.client.DeleteItem(ctx, itemID)
+}
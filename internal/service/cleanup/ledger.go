@@ -0,0 +1,289 @@
+package cleanup
+
+import (
+	"encoding/json"
+	"fmt"
+	"os"
+	"sort"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/fusionn-air/internal/client/radarr"
+	"github.com/fusionn-air/internal/client/sonarr"
+	"github.com/fusionn-air/pkg/fileutil"
+)
+
+// LedgerTypeSeasons marks ledger entries for seasons deleted by season
+// cleanup. The series stays in Sonarr, so they're a record, not restorable.
+const LedgerTypeSeasons MediaType = "seasons"
+
+// LedgerEntry records a deleted Sonarr/Radarr item with everything needed to
+// add it back with the same settings
+type LedgerEntry struct {
+	Type       MediaType `json:"type"`
+	ExternalID int       `json:"external_id"` // TVDB for series, TMDB for movies
+	Title      string    `json:"title"`
+	Year       int       `json:"year,omitempty"`
+	ImdbID     string    `json:"imdb_id,omitempty"`
+
+	QualityProfileID  int            `json:"quality_profile_id"`
+	LanguageProfileID int            `json:"language_profile_id,omitempty"` // Sonarr v3
+	RootFolder        string         `json:"root_folder"`
+	Path              string         `json:"path"`
+	Tags              []int          `json:"tags,omitempty"`
+	SeriesType        string         `json:"series_type,omitempty"`
+	SeasonFolder      bool           `json:"season_folder,omitempty"`
+	Seasons           []LedgerSeason `json:"seasons,omitempty"`
+	Availability      string         `json:"minimum_availability,omitempty"` // Radarr
+
+	SizeOnDisk int64      `json:"size_on_disk"`
+	Reason     string     `json:"reason"`
+	DeletedAt  time.Time  `json:"deleted_at"`
+	RestoredAt *time.Time `json:"restored_at,omitempty"`
+}
+
+// LedgerSeason is a season's monitored state at deletion time
+type LedgerSeason struct {
+	Number    int  `json:"number"`
+	Monitored bool `json:"monitored"`
+}
+
+// Ledger persists deleted items so they can be restored
+type Ledger struct {
+	mu       sync.RWMutex
+	entries  map[string]*LedgerEntry // keyed by type:external ID
+	filePath string
+}
+
+// NewLedgerWithFile creates a new ledger with a specific file path
+func NewLedgerWithFile(path string) *Ledger {
+	l := &Ledger{
+		entries:  make(map[string]*LedgerEntry),
+		filePath: path,
+	}
+	_ = l.load()
+	return l
+}
+
+func ledgerKey(t MediaType, externalID int) string {
+	return fmt.Sprintf("%s:%d", t, externalID)
+}
+
+// Add records a deletion, replacing any earlier entry for the same item
+func (l *Ledger) Add(entry *LedgerEntry) {
+	l.mu.Lock()
+	defer l.mu.Unlock()
+
+	l.entries[ledgerKey(entry.Type, entry.ExternalID)] = entry
+	_ = l.save()
+}
+
+// AddSeason records a season whose files season cleanup deleted, keeping
+// the series' earlier season deletions
+func (l *Ledger) AddSeason(ser *sonarr.Series, season int, size int64, reason string) {
+	l.mu.Lock()
+	defer l.mu.Unlock()
+
+	key := ledgerKey(LedgerTypeSeasons, ser.TvdbID)
+	entry, exists := l.entries[key]
+	// Seasons deleted before the series was restored are back on disk
+	if !exists || entry.RestoredAt != nil {
+		entry = &LedgerEntry{
+			Type:       LedgerTypeSeasons,
+			ExternalID: ser.TvdbID,
+			Title:      ser.Title,
+			Year:       ser.Year,
+			Path:       ser.Path,
+		}
+		l.entries[key] = entry
+	}
+	seasons := entry.Seasons[:0]
+	for _, s := range entry.Seasons {
+		if s.Number != season {
+			seasons = append(seasons, s)
+		}
+	}
+	entry.Seasons = append(seasons, LedgerSeason{Number: season, Monitored: true})
+	entry.SizeOnDisk += size
+	entry.Reason = reason
+	entry.DeletedAt = time.Now()
+	_ = l.save()
+}
+
+// SeasonDeleted reports whether cleanup deleted a season of a series, on
+// its own or with the whole series, and it hasn't been restored since
+func (l *Ledger) SeasonDeleted(tvdbID, season int) bool {
+	l.mu.RLock()
+	defer l.mu.RUnlock()
+
+	if entry := l.entries[ledgerKey(MediaTypeSeries, tvdbID)]; entry != nil && entry.RestoredAt == nil {
+		return true
+	}
+	if entry := l.entries[ledgerKey(LedgerTypeSeasons, tvdbID)]; entry != nil && entry.RestoredAt == nil {
+		for _, s := range entry.Seasons {
+			if s.Number == season {
+				return true
+			}
+		}
+	}
+	return false
+}
+
+// Get returns the entry for an item, or nil
+func (l *Ledger) Get(t MediaType, externalID int) *LedgerEntry {
+	l.mu.RLock()
+	defer l.mu.RUnlock()
+
+	return l.entries[ledgerKey(t, externalID)]
+}
+
+// GetAll returns all entries, most recently deleted first
+func (l *Ledger) GetAll() []*LedgerEntry {
+	l.mu.RLock()
+	defer l.mu.RUnlock()
+
+	entries := make([]*LedgerEntry, 0, len(l.entries))
+	for _, entry := range l.entries {
+		entries = append(entries, entry)
+	}
+	sort.Slice(entries, func(i, j int) bool {
+		return entries[i].DeletedAt.After(entries[j].DeletedAt)
+	})
+	return entries
+}
+
+// MarkRestored sets the restored timestamp for an entry. Restoring a series
+// also restores the seasons season cleanup deleted earlier.
+func (l *Ledger) MarkRestored(t MediaType, externalID int) {
+	l.mu.Lock()
+	defer l.mu.Unlock()
+
+	keys := []string{ledgerKey(t, externalID)}
+	if t == MediaTypeSeries {
+		keys = append(keys, ledgerKey(LedgerTypeSeasons, externalID))
+	}
+
+	now := time.Now()
+	changed := false
+	for _, key := range keys {
+		if entry, exists := l.entries[key]; exists {
+			entry.RestoredAt = &now
+			changed = true
+		}
+	}
+	if changed {
+		_ = l.save()
+	}
+}
+
+// Prune drops entries deleted more than days ago
+func (l *Ledger) Prune(days int) int {
+	l.mu.Lock()
+	defer l.mu.Unlock()
+
+	cutoff := time.Now().AddDate(0, 0, -days)
+	pruned := 0
+	for key, entry := range l.entries {
+		if entry.DeletedAt.Before(cutoff) {
+			delete(l.entries, key)
+			pruned++
+		}
+	}
+	if pruned > 0 {
+		_ = l.save()
+	}
+	return pruned
+}
+
+// load reads the ledger from disk
+func (l *Ledger) load() error {
+	data, err := os.ReadFile(l.filePath)
+	if err != nil {
+		return err
+	}
+
+	var entries []*LedgerEntry
+	if err := json.Unmarshal(data, &entries); err != nil {
+		return err
+	}
+
+	for _, entry := range entries {
+		l.entries[ledgerKey(entry.Type, entry.ExternalID)] = entry
+	}
+
+	return nil
+}
+
+// save writes the ledger to disk
+func (l *Ledger) save() error {
+	entries := make([]*LedgerEntry, 0, len(l.entries))
+	for _, entry := range l.entries {
+		entries = append(entries, entry)
+	}
+
+	data, err := json.MarshalIndent(entries, "", "  ")
+	if err != nil {
+		return err
+	}
+
+	return fileutil.WriteAtomic(l.filePath, data)
+}
+
+// seriesLedgerEntry captures a Sonarr series just before it is deleted
+func seriesLedgerEntry(ser *sonarr.Series, reason string) *LedgerEntry {
+	entry := &LedgerEntry{
+		Type:              MediaTypeSeries,
+		ExternalID:        ser.TvdbID,
+		Title:             ser.Title,
+		Year:              ser.Year,
+		ImdbID:            ser.ImdbID,
+		QualityProfileID:  ser.QualityProfileID,
+		LanguageProfileID: ser.LanguageProfileID,
+		RootFolder:        parentDir(ser.Path),
+		Path:              ser.Path,
+		Tags:              ser.Tags,
+		SeriesType:        ser.SeriesType,
+		SeasonFolder:      ser.SeasonFolder,
+		SizeOnDisk:        ser.Statistics.SizeOnDisk,
+		Reason:            reason,
+		DeletedAt:         time.Now(),
+	}
+	for _, season := range ser.Seasons {
+		// Seasons with files were wanted even if cleanup unmonitored them
+		hasFiles := season.Statistics != nil && season.Statistics.EpisodeFileCount > 0
+		entry.Seasons = append(entry.Seasons, LedgerSeason{
+			Number:    season.SeasonNumber,
+			Monitored: season.Monitored || hasFiles,
+		})
+	}
+	return entry
+}
+
+// movieLedgerEntry captures a Radarr movie just before it is deleted
+func movieLedgerEntry(movie *radarr.Movie, reason string) *LedgerEntry {
+	return &LedgerEntry{
+		Type:             MediaTypeMovie,
+		ExternalID:       movie.TmdbID,
+		Title:            movie.Title,
+		Year:             movie.Year,
+		ImdbID:           movie.ImdbID,
+		QualityProfileID: movie.QualityProfileID,
+		RootFolder:       parentDir(movie.Path),
+		Path:             movie.Path,
+		Tags:             movie.Tags,
+		Availability:     movie.MinimumAvailability,
+		SizeOnDisk:       movie.SizeOnDisk,
+		Reason:           reason,
+		DeletedAt:        time.Now(),
+	}
+}
+
+// parentDir returns the folder containing path (Unix or Windows separators)
+func parentDir(path string) string {
+	trimmed := strings.TrimRight(path, `/\`)
+	if i := strings.LastIndexAny(trimmed, `/\`); i > 0 {
+		return trimmed[:i]
+	}
+	return trimmed
+}
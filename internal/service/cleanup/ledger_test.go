@@ -0,0 +1,74 @@
+package cleanup
+
+import (
+	"path/filepath"
+	"testing"
+
+	"github.com/fusionn-air/internal/client/sonarr"
+)
+
+func TestLedgerSeasonDeleted(t *testing.T) {
+	ser := &sonarr.Series{ID: 1, TvdbID: 81189, Title: "Breaking Bad"}
+
+	tests := []struct {
+		name  string
+		setup func(l *Ledger)
+		want  map[int]bool // Season → deleted
+	}{
+		{
+			name:  "nothing deleted",
+			setup: func(l *Ledger) {},
+			want:  map[int]bool{1: false},
+		},
+		{
+			name: "trimmed seasons",
+			setup: func(l *Ledger) {
+				l.AddSeason(ser, 1, 100, "old season")
+				l.AddSeason(ser, 2, 100, "old season")
+			},
+			want: map[int]bool{1: true, 2: true, 3: false},
+		},
+		{
+			name: "whole series deleted",
+			setup: func(l *Ledger) {
+				l.Add(&LedgerEntry{Type: MediaTypeSeries, ExternalID: ser.TvdbID})
+			},
+			want: map[int]bool{1: true, 5: true},
+		},
+		{
+			name: "series restored after seasons were trimmed",
+			setup: func(l *Ledger) {
+				l.AddSeason(ser, 1, 100, "old season")
+				l.Add(&LedgerEntry{Type: MediaTypeSeries, ExternalID: ser.TvdbID})
+				l.MarkRestored(MediaTypeSeries, ser.TvdbID)
+			},
+			want: map[int]bool{1: false, 2: false},
+		},
+		{
+			name: "season trimmed again after restore",
+			setup: func(l *Ledger) {
+				l.AddSeason(ser, 1, 100, "old season")
+				l.Add(&LedgerEntry{Type: MediaTypeSeries, ExternalID: ser.TvdbID})
+				l.MarkRestored(MediaTypeSeries, ser.TvdbID)
+				l.AddSeason(ser, 2, 100, "old season")
+			},
+			want: map[int]bool{1: false, 2: true},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			path := filepath.Join(t.TempDir(), "ledger.json")
+			l := NewLedgerWithFile(path)
+			tt.setup(l)
+
+			// Reload to check what was persisted
+			l = NewLedgerWithFile(path)
+			for season, want := range tt.want {
+				if got := l.SeasonDeleted(ser.TvdbID, season); got != want {
+					t.Errorf("SeasonDeleted(S%02d) = %v, want %v", season, got, want)
+				}
+			}
+		})
+	}
+}
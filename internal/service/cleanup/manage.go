@@ -0,0 +1,211 @@
+package cleanup
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/fusionn-air/internal/config"
+	"github.com/fusionn-air/pkg/logger"
+)
+
+var (
+	// ErrUnknownMediaType is returned for a media type without a queue
+	ErrUnknownMediaType = errors.New("unknown media type")
+	// ErrNotQueued is returned when the item isn't in the cleanup queue
+	ErrNotQueued = errors.New("not in cleanup queue")
+	// ErrNotPinned is returned when unpinning an entry that isn't pinned
+	ErrNotPinned = errors.New("not pinned")
+	// ErrRuleKeepsFiles is returned when force-deleting an item whose
+	// cleanup rule only unmonitors it
+	ErrRuleKeepsFiles = errors.New("cleanup rule keeps the files")
+)
+
+// queuedItem returns a queued item, or an error when the type or item is unknown
+func (s *Service) queuedItem(t MediaType, id int) (*Queue, *QueueItem, error) {
+	queue := s.queues[t]
+	if queue == nil {
+		return nil, nil, fmt.Errorf("%w: %q", ErrUnknownMediaType, t)
+	}
+	item := queue.Get(id)
+	if item == nil {
+		return nil, nil, ErrNotQueued
+	}
+	return queue, item, nil
+}
+
+// Dequeue removes an item from the queue and re-monitors it in Sonarr/Radarr
+func (s *Service) Dequeue(ctx context.Context, t MediaType, id int) (*QueueItem, error) {
+	queue, item, err := s.queuedItem(t, id)
+	if err != nil {
+		return nil, err
+	}
+
+	if err := s.dequeue(ctx, queue, id, "removed via API"); err != nil {
+		return item, err
+	}
+	return item, nil
+}
+
+// dequeue drops an item that's no longer going to be deleted and restores
+// the monitoring it had when it was queued
+func (s *Service) dequeue(ctx context.Context, queue *Queue, id int, why string) error {
+	item := queue.Get(id)
+	if item == nil {
+		return nil
+	}
+
+	if err := queue.Remove(id); err != nil {
+		return fmt.Errorf("saving queue: %w", err)
+	}
+	logger.Infof("➖ Removed %s from cleanup queue (%s)", item.Title, why)
+
+	if err := s.restoreMonitoring(ctx, item); err != nil {
+		return fmt.Errorf("dequeued, but restoring monitoring failed: %w", err)
+	}
+	return nil
+}
+
+// restoreMonitoring undoes the unmonitor done when an item was queued
+func (s *Service) restoreMonitoring(ctx context.Context, item *QueueItem) error {
+	if item.UnmonitoredAt == nil {
+		return nil
+	}
+
+	state := item.MonitorState
+	if state == nil {
+		// Queued before monitoring was recorded; only monitored items get queued
+		state = &MonitorState{Monitored: true}
+	}
+
+	switch item.Type {
+	case MediaTypeSeries:
+		if s.sonarr == nil {
+			return errors.New("sonarr is not configured")
+		}
+		return s.sonarr.SetSeriesMonitoring(ctx, item.ID, state.Monitored, state.Seasons)
+	case MediaTypeMovie:
+		if s.radarr == nil {
+			return errors.New("radarr is not configured")
+		}
+		if !state.Monitored {
+			return nil
+		}
+		return s.radarr.MonitorMovie(ctx, item.ID)
+	}
+	return nil
+}
+
+// Postpone pushes an item's removal back by the given number of days,
+// counted from its current due date (or from now if it's already due)
+func (s *Service) Postpone(t MediaType, id int, days int) (*QueueItem, error) {
+	if days <= 0 {
+		return nil, errors.New("days must be positive")
+	}
+
+	queue, item, err := s.queuedItem(t, id)
+	if err != nil {
+		return nil, err
+	}
+
+	delay := itemDelay(s.cfgMgr.Get(), item)
+	due := item.MarkedAt.AddDate(0, 0, delay)
+	if now := time.Now(); due.Before(now) {
+		due = now
+	}
+	due = due.AddDate(0, 0, days)
+
+	if err := queue.Reschedule(id, due.AddDate(0, 0, -delay)); err != nil {
+		return nil, fmt.Errorf("saving queue: %w", err)
+	}
+	logger.Infof("⏸️  Postponed %s until %s", item.Title, due.Format("2006-01-02"))
+	return queue.Get(id), nil
+}
+
+// Pin excludes a queued item from cleanup for good, then dequeues and
+// re-monitors it. Returns the exclusion entry that was added.
+func (s *Service) Pin(ctx context.Context, t MediaType, id int) (string, error) {
+	_, item, err := s.queuedItem(t, id)
+	if err != nil {
+		return "", err
+	}
+
+	entry := pinEntry(item)
+	if err := s.pins.Add(entry); err != nil {
+		return "", fmt.Errorf("saving pins: %w", err)
+	}
+	logger.Infof("📌 Pinned %s (%s)", item.Title, entry)
+
+	if _, err := s.Dequeue(ctx, t, id); err != nil {
+		return entry, err
+	}
+	return entry, nil
+}
+
+// GetPins returns the exclusion entries pinned through the API
+func (s *Service) GetPins() []string {
+	return s.pins.GetAll()
+}
+
+// Unpin removes a pinned exclusion entry
+func (s *Service) Unpin(entry string) error {
+	removed, err := s.pins.Remove(entry)
+	if err != nil {
+		return fmt.Errorf("saving pins: %w", err)
+	}
+	if !removed {
+		return ErrNotPinned
+	}
+	logger.Infof("📌 Unpinned %s", entry)
+	return nil
+}
+
+// ForceDelete removes a queued item now, ignoring its delay. Honors dry_run.
+// Items under an unmonitor-only rule are refused rather than deleted.
+func (s *Service) ForceDelete(ctx context.Context, t MediaType, id int) ([]MediaResult, error) {
+	// Wait for a running cleanup, which may be removing the same item
+	s.runMu.Lock()
+	defer s.runMu.Unlock()
+
+	queue, item, err := s.queuedItem(t, id)
+	if err != nil {
+		return nil, err
+	}
+
+	cfg := s.cfgMgr.Get()
+	if rule := ruleByName(cfg.Cleanup.Rules, item.Rule); ruleAction(rule) == config.RuleActionUnmonitor {
+		return nil, fmt.Errorf("%w: rule %q only unmonitors %s", ErrRuleKeepsFiles, ruleName(rule), item.Title)
+	}
+
+	dryRun := cfg.Scheduler.DryRun
+	startTime := time.Now()
+	result := &ProcessingResult{
+		Stats: make(map[MediaType]*MediaStats),
+	}
+	items := []*QueueItem{item}
+
+	logger.Infof("🗑️  Force-deleting %s", item.Title)
+
+	switch t {
+	case MediaTypeSeries:
+		if s.sonarr == nil {
+			return nil, errors.New("sonarr is not configured")
+		}
+		s.removeSeriesItems(ctx, result, queue, items, nil, cfg, dryRun)
+	case MediaTypeMovie:
+		if s.radarr == nil {
+			return nil, errors.New("radarr is not configured")
+		}
+		s.removeMovieItems(ctx, result, queue, items, nil, cfg, dryRun)
+	default:
+		server, err := s.mediaServerFor(t)
+		if err != nil {
+			return nil, err
+		}
+		s.removeOrphanItems(ctx, result, server, t, queue, items, dryRun)
+	}
+
+	s.recordRun(result, startTime, dryRun)
+	return result.Results, nil
+}
@@ -3,11 +3,9 @@ package cleanup
 import (
 	"context"
 	"fmt"
-	"strings"
 	"time"
 
 	"github.com/fusionn-air/internal/client/radarr"
-	"github.com/fusionn-air/internal/client/trakt"
 	"github.com/fusionn-air/internal/config"
 	"github.com/fusionn-air/pkg/logger"
 )
@@ -37,28 +35,26 @@ func (s *Service) processMovies(ctx context.Context, result *ProcessingResult, c
 	result.IncrementScanned(MediaTypeMovie, len(movies))
 	logger.Infof("🎬 Found %d movies in Radarr", len(movies))
 
-	// Get watched movies from Trakt
-	logger.Info("👁️  Fetching movie watch history from Trakt...")
-	watchedMovies, err := s.trakt.GetWatchedMovies(ctx)
-	if err != nil {
+	// Get watched movies for everyone the watch policy evaluates
+	viewers, requireAll := s.viewers(cfg)
+	logger.Infof("👁️  Fetching movie watch history from %s...", sourceName(viewers))
+	if err := loadWatchedMovies(ctx, viewers); err != nil {
 		logger.Errorf("❌ Failed to get watched movies: %v", err)
 		return
 	}
 
-	// Build lookup by TMDB ID
-	watchedByTmdb := make(map[int]*trakt.WatchedMovie)
-	for i := range watchedMovies {
-		if watchedMovies[i].Movie.IDs.TMDB > 0 {
-			watchedByTmdb[watchedMovies[i].Movie.IDs.TMDB] = &watchedMovies[i]
-		}
-	}
+	s.checkRewatches(ctx, queue, viewers, cfg, dryRun)
+
+	labels := s.radarrLabels(ctx, cfg)
 
 	// Process each movie
 	for _, movie := range movies {
-		res := s.processOneMovie(&movie, watchedByTmdb, queue, cfg)
-		// Unmonitor if newly queued
-		if res.Action == "queued" && strings.HasSuffix(res.Reason, "added to queue") {
-			s.unmonitorMovie(ctx, movie.ID, movie.Title, queue, dryRun)
+		res := s.processOneMovie(ctx, &movie, labels, viewers, requireAll, queue, cfg)
+		// Unmonitor queued movies that haven't been yet
+		if res.Action == "queued" {
+			if item := queue.Get(movie.ID); item != nil && item.UnmonitoredAt == nil {
+				s.unmonitorMovie(ctx, movie.ID, movie.Title, queue, dryRun)
+			}
 		}
 		// Only add non-empty results (skip items ready for removal)
 		if res.ID != 0 {
@@ -70,19 +66,43 @@ func (s *Service) processMovies(ctx context.Context, result *ProcessingResult, c
 	return
 }
 
-func (s *Service) processOneMovie(movie *radarr.Movie, watchedByTmdb map[int]*trakt.WatchedMovie, queue *Queue, cfg *config.Config) MediaResult {
+func (s *Service) processOneMovie(ctx context.Context, movie *radarr.Movie, labels arrLabels, viewers []*viewer, requireAll bool, queue *Queue, cfg *config.Config) MediaResult {
 	res := MediaResult{
 		Type:       MediaTypeMovie,
 		Title:      movie.Title,
 		ID:         movie.ID,
+		ExternalID: movie.TmdbID,
 		Year:       movie.Year,
 		SizeOnDisk: radarr.FormatSize(movie.SizeOnDisk),
 	}
 
 	// Check exclusions
-	if isExcluded(movie.Title, cfg.Cleanup.Exclusions) {
+	if exc := matchExclusion(s.exclusions(cfg), exclusionSubject{title: movie.Title, tmdbID: movie.TmdbID, imdbID: movie.ImdbID, tags: labels.tagLabels(movie.Tags)}); exc != "" {
+		if err := s.dequeue(ctx, queue, movie.ID, "excluded"); err != nil {
+			logger.Warnf("⚠️  Failed to dequeue %s: %v", res.Title, err)
+		}
+		res.Action = "skipped"
+		res.Reason = exclusionReason(exc)
+		return res
+	}
+
+	if reason := s.protectedReason(res.Type, res.ExternalID); reason != "" {
+		if err := s.dequeue(ctx, queue, res.ID, reason); err != nil {
+			logger.Warnf("⚠️  Failed to dequeue %s: %v", res.Title, err)
+		}
+		res.Action = "skipped"
+		res.Reason = reason
+		return res
+	}
+
+	// Apply the first matching cleanup rule
+	rule := matchRule(cfg.Cleanup.Rules, movieSubject(movie, labels))
+	if ruleAction(rule) == config.RuleActionNever {
+		if err := s.dequeue(ctx, queue, movie.ID, "never-delete rule"); err != nil {
+			logger.Warnf("⚠️  Failed to dequeue %s: %v", res.Title, err)
+		}
 		res.Action = "skipped"
-		res.Reason = "in exclusion list"
+		res.Reason = fmt.Sprintf("never deleted (rule: %s)", ruleName(rule))
 		return res
 	}
 
@@ -92,14 +112,14 @@ func (s *Service) processOneMovie(movie *radarr.Movie, watchedByTmdb map[int]*tr
 	if queue.IsQueued(movie.ID) {
 		// If item is ready for removal, skip it here so it doesn't appear twice
 		// It will be processed by processMovieRemovalQueue and appear as "removed"
-		if queue.IsReadyForRemoval(movie.ID, cfg.Cleanup.DelayDays) {
+		if queue.IsReadyForRemoval(movie.ID, ruleDelay(cfg)) {
 			// Return empty result - this item will be handled by removal queue
 			return MediaResult{}
 		}
 
 		queueItem := queue.Get(movie.ID)
 		daysInQueue := int(time.Since(queueItem.MarkedAt).Hours() / 24)
-		daysUntil := cfg.Cleanup.DelayDays - daysInQueue
+		daysUntil := itemDelay(cfg, queueItem) - daysInQueue
 		if daysUntil < 0 {
 			daysUntil = 0
 		}
@@ -127,42 +147,75 @@ func (s *Service) processOneMovie(movie *radarr.Movie, watchedByTmdb map[int]*tr
 		return res
 	}
 
-	// Find in Trakt watched movies
-	watched, found := watchedByTmdb[movie.TmdbID]
-	if !found {
+	// Check watch policy
+	verdict := evaluateMovie(viewers, requireAll, movie.TmdbID)
+	if !verdict.watched {
 		res.Action = "skipped"
-		res.Reason = "not watched"
+		res.Reason = verdict.reason
 		return res
 	}
 
 	// Movie is watched
-	watchedReason := fmt.Sprintf("watched %s", watched.LastWatchedAt.Format("2006-01-02"))
+	watchedReason := fmt.Sprintf("watched %s%s", verdict.lastWatchedAt.Format("2006-01-02"), watchedBySuffix(viewers, verdict.watchedBy))
+
+	if rule != nil {
+		watchedReason += fmt.Sprintf(" [rule: %s]", ruleName(rule))
+	}
+
+	// Someone is rewatching it; keep it off the queue for now
+	if held, reason := s.rewatchHeld(MediaTypeMovie, movie.TmdbID, viewers, ruleDelayDays(cfg, rule)); held {
+		res.Action = "skipped"
+		res.Reason = reason
+		return res
+	}
 
 	// Add to queue
-	queue.Add(&QueueItem{
-		ID:         movie.ID,
-		ExternalID: movie.TmdbID,
-		Title:      movie.Title,
-		MarkedAt:   time.Now(),
-		Reason:     watchedReason,
-		SizeOnDisk: movie.SizeOnDisk,
-	})
+	if err := queue.Add(&QueueItem{
+		ID:           movie.ID,
+		ExternalID:   movie.TmdbID,
+		Title:        movie.Title,
+		MarkedAt:     time.Now(),
+		Reason:       watchedReason,
+		SizeOnDisk:   movie.SizeOnDisk,
+		Path:         movie.Path,
+		Rule:         ruleName(rule),
+		MonitorState: &MonitorState{Monitored: movie.Monitored},
+	}); err != nil {
+		logger.Errorf("❌ Failed to queue %s: %v", res.Title, err)
+		res.Action = "error"
+		res.Reason = fmt.Sprintf("queue save failed: %v", err)
+		return res
+	}
 
 	res.Action = "queued"
 	res.Reason = watchedReason + " - queued for deletion (unmonitored)"
-	res.DaysUntil = cfg.Cleanup.DelayDays
+	res.DaysUntil = ruleDelayDays(cfg, rule)
 	return res
 }
 
 func (s *Service) processMovieRemovalQueue(ctx context.Context, result *ProcessingResult, queue *Queue, cfg *config.Config, dryRun bool) {
-	ready := queue.GetReadyForRemoval(cfg.Cleanup.DelayDays)
-	if len(ready) == 0 {
+	ready := queue.GetReadyForRemoval(ruleDelay(cfg))
+	early := s.diskPressureRemovals(ctx, "Radarr", s.radarrRootFolders, queue, ready, cfg)
+	if len(ready) == 0 && len(early) == 0 {
 		return
 	}
 
-	logger.Infof("🗑️  %d movies ready for removal", len(ready))
+	if len(ready) > 0 {
+		logger.Infof("🗑️  %d movies ready for removal", len(ready))
+	}
+
+	earlyIDs := make(map[int]bool, len(early))
+	for _, item := range early {
+		earlyIDs[item.ID] = true
+	}
+
+	s.removeMovieItems(ctx, result, queue, append(ready, early...), earlyIDs, cfg, dryRun)
+}
 
-	for _, item := range ready {
+// removeMovieItems deletes queued items and drops them from the queue.
+// Items in earlyIDs are removed ahead of their delay (disk pressure).
+func (s *Service) removeMovieItems(ctx context.Context, result *ProcessingResult, queue *Queue, items []*QueueItem, earlyIDs map[int]bool, cfg *config.Config, dryRun bool) {
+	for _, item := range items {
 		movie, err := s.radarr.GetMovie(ctx, item.ID)
 		if err != nil {
 			logger.Errorf("❌ Error checking movie %s: %v", item.Title, err)
@@ -171,7 +224,26 @@ func (s *Service) processMovieRemovalQueue(ctx context.Context, result *Processi
 
 		if movie == nil {
 			logger.Infof("ℹ️  %s already removed, clearing from queue", item.Title)
-			queue.Remove(item.ID)
+			if err := queue.Remove(item.ID); err != nil {
+				logger.Warnf("⚠️  Failed to remove %s from queue: %v", item.Title, err)
+			}
+			continue
+		}
+
+		deletedReason, dryRunReason := "deleted", "would be deleted"
+		if earlyIDs[item.ID] {
+			result.dropQueued(MediaTypeMovie, item.ID)
+			deletedReason, dryRunReason = "deleted early (disk pressure)", "would be deleted early (disk pressure)"
+		}
+
+		// Trashing on the same disk frees nothing, so disk pressure deletes outright
+		toTrash := cfg.Cleanup.Trash.Enabled && !earlyIDs[item.ID]
+		if toTrash {
+			deletedReason, dryRunReason = "moved to trash", "would be moved to trash"
+		}
+
+		if ruleAction(ruleByName(cfg.Cleanup.Rules, item.Rule)) == config.RuleActionUnmonitor {
+			s.keepMovieFiles(ctx, result, queue, item, dryRun)
 			continue
 		}
 
@@ -181,34 +253,102 @@ func (s *Service) processMovieRemovalQueue(ctx context.Context, result *Processi
 				Type:       MediaTypeMovie,
 				Title:      item.Title,
 				ID:         item.ID,
+				ExternalID: item.ExternalID,
 				Action:     "dry_run_remove",
-				Reason:     "would be deleted",
+				Reason:     dryRunReason,
 				SizeOnDisk: radarr.FormatSize(item.SizeOnDisk),
 			})
 		} else {
-			if err := s.radarr.DeleteMovie(ctx, item.ID, true); err != nil {
+			if err := s.deleteMovie(ctx, movie, toTrash, cfg); err != nil {
 				logger.Errorf("❌ Failed to delete movie %s: %v", item.Title, err)
 				result.AddResult(MediaResult{
-					Type:   MediaTypeMovie,
-					Title:  item.Title,
-					ID:     item.ID,
-					Action: "error",
-					Reason: fmt.Sprintf("delete failed: %v", err),
+					Type:       MediaTypeMovie,
+					Title:      item.Title,
+					ID:         item.ID,
+					ExternalID: item.ExternalID,
+					Action:     "error",
+					Reason:     fmt.Sprintf("delete failed: %v", err),
 				})
 				continue
 			}
+			s.ledger.Add(movieLedgerEntry(movie, deletedReason))
 			logger.Infof("✅ Deleted movie: %s (%s freed)", item.Title, radarr.FormatSize(item.SizeOnDisk))
 			result.AddResult(MediaResult{
 				Type:       MediaTypeMovie,
 				Title:      item.Title,
 				ID:         item.ID,
+				ExternalID: item.ExternalID,
 				Action:     "removed",
-				Reason:     "deleted",
+				Reason:     deletedReason,
 				SizeOnDisk: radarr.FormatSize(item.SizeOnDisk),
 			})
 		}
 
-		queue.Remove(item.ID)
+		if err := queue.Remove(item.ID); err != nil {
+			logger.Warnf("⚠️  Failed to remove %s from queue: %v", item.Title, err)
+		}
+	}
+}
+
+// deleteMovie removes a movie from Radarr. With soft delete the movie is
+// unmonitored, its folder moved to the trash, and Radarr keeps its hands off
+// the files.
+func (s *Service) deleteMovie(ctx context.Context, movie *radarr.Movie, toTrash bool, cfg *config.Config) error {
+	if !toTrash {
+		return s.radarr.DeleteMovie(ctx, movie.ID, true)
+	}
+
+	if err := s.radarr.UnmonitorMovie(ctx, movie.ID); err != nil {
+		return fmt.Errorf("unmonitoring: %w", err)
+	}
+
+	trashed, err := s.moveToTrash(MediaTypeMovie, movie.TmdbID, movie.Title, movie.Path, movie.SizeOnDisk, cfg)
+	if err != nil {
+		return err
+	}
+
+	if err := s.radarr.DeleteMovie(ctx, movie.ID, false); err != nil {
+		// Put the folder back so Radarr still finds its files
+		if restoreErr := s.restoreFromTrash(MediaTypeMovie, movie.TmdbID); restoreErr != nil {
+			logger.Errorf("❌ Failed to move %s back from trash: %v", movie.Title, restoreErr)
+		}
+		return err
+	}
+
+	logger.Infof("🗑️  Moved to trash: %s → %s", movie.Title, trashed.TrashPath)
+	return nil
+}
+
+// keepMovieFiles handles a ready item whose rule only unmonitors: the movie stays
+// in Radarr with its files
+func (s *Service) keepMovieFiles(ctx context.Context, result *ProcessingResult, queue *Queue, item *QueueItem, dryRun bool) {
+	res := MediaResult{
+		Type:       MediaTypeMovie,
+		Title:      item.Title,
+		ID:         item.ID,
+		ExternalID: item.ExternalID,
+		Action:     "skipped",
+		SizeOnDisk: radarr.FormatSize(item.SizeOnDisk),
+	}
+
+	if dryRun {
+		logger.Warnf("🔕 [DRY RUN] Would unmonitor (keeping files): %s", item.Title)
+		res.Reason = fmt.Sprintf("would unmonitor, files kept (rule: %s)", item.Rule)
+	} else {
+		if err := s.radarr.UnmonitorMovie(ctx, item.ID); err != nil {
+			logger.Errorf("❌ Failed to unmonitor %s: %v", item.Title, err)
+			res.Action = "error"
+			res.Reason = fmt.Sprintf("unmonitor failed: %v", err)
+			result.AddResult(res)
+			return
+		}
+		logger.Infof("🔕 Unmonitored (keeping files): %s", item.Title)
+		res.Reason = fmt.Sprintf("unmonitored, files kept (rule: %s)", item.Rule)
+	}
+
+	result.AddResult(res)
+	if err := queue.Remove(item.ID); err != nil {
+		logger.Warnf("⚠️  Failed to remove %s from queue: %v", item.Title, err)
 	}
 }
 
@@ -225,5 +365,7 @@ func (s *Service) unmonitorMovie(ctx context.Context, movieID int, title string,
 	}
 
 	logger.Infof("🔕 Unmonitored movie: %s (queued for deletion)", title)
-	queue.MarkUnmonitored(movieID)
+	if err := queue.MarkUnmonitored(movieID); err != nil {
+		logger.Warnf("⚠️  Failed to save unmonitored state for %s: %v", title, err)
+	}
 }
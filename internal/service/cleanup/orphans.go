@@ -0,0 +1,452 @@
+package cleanup
+
+import (
+	"context"
+	"fmt"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/fusionn-air/internal/client/trakt"
+	"github.com/fusionn-air/internal/config"
+	"github.com/fusionn-air/pkg/logger"
+)
+
+// mediaServer is a media server whose libraries are cleaned up directly:
+// items Sonarr/Radarr don't manage ("orphans") are checked against watch
+// history and deleted from the server itself. Each server only supplies
+// its library items and file lookups; the processing is shared.
+type mediaServer interface {
+	// Name is a human-readable server name for logs and reasons
+	Name() string
+	// Enabled reports whether the server's cleanup is turned on
+	Enabled(cfg *config.Config) bool
+	// Types returns the queue media types for the server's series and movies
+	Types() (series, movies MediaType)
+	// Libraries returns the series and movies of non-excluded libraries,
+	// only fetching the kinds asked for
+	Libraries(ctx context.Context, cfg *config.Config, wantSeries, wantMovies bool) (series, movies []orphanItem)
+	// SeasonFiles returns the number of episodes with files per season
+	SeasonFiles(ctx context.Context, itemID string) (map[int]int, error)
+	DeleteItem(ctx context.Context, itemID string) error
+}
+
+// orphanItem is a media server series or movie
+type orphanItem struct {
+	queueID int    // Queue key: numeric item ID, or TVDB/TMDB ID when item IDs are GUIDs
+	itemID  string // Server item ID, used for file lookups and deletion
+	title   string
+	tvdbID  int
+	tmdbID  int
+	imdbID  string
+	subject ruleSubject // What cleanup rules match against
+}
+
+// mediaServers returns the configured media servers
+func (s *Service) mediaServers() []mediaServer {
+	var servers []mediaServer
+	if s.emby != nil {
+		servers = append(servers, &embyServer{client: s.emby})
+	}
+	if s.jellyfin != nil {
+		servers = append(servers, &jellyfinServer{client: s.jellyfin})
+	}
+	if s.plex != nil {
+		servers = append(servers, &plexServer{client: s.plex})
+	}
+	return servers
+}
+
+// mediaServerFor returns the media server owning a queue media type
+func (s *Service) mediaServerFor(t MediaType) (mediaServer, error) {
+	for _, server := range s.mediaServers() {
+		if series, movies := server.Types(); t == series || t == movies {
+			return server, nil
+		}
+	}
+	name := strings.TrimSuffix(strings.TrimSuffix(string(t), "_series"), "_movie")
+	return nil, fmt.Errorf("%s is not configured", name)
+}
+
+// processMediaServer runs orphan cleanup for one media server. Nil ID sets
+// mean Sonarr/Radarr data is unavailable, so those libraries are skipped.
+func (s *Service) processMediaServer(ctx context.Context, result *ProcessingResult, cfg *config.Config, dryRun bool, server mediaServer, sonarrTvdbIDs, radarrTmdbIDs map[int]bool) {
+	series, movies := server.Libraries(ctx, cfg, sonarrTvdbIDs != nil, radarrTmdbIDs != nil)
+
+	// Process aggregated items (fetches watch history once per type)
+	if len(movies) > 0 {
+		s.processOrphanMovies(ctx, result, cfg, dryRun, server, radarrTmdbIDs, movies)
+	} else if radarrTmdbIDs != nil {
+		logger.Infof("🎬 No movies found in non-excluded %s movie libraries", server.Name())
+	}
+
+	if len(series) > 0 {
+		s.processOrphanSeries(ctx, result, cfg, dryRun, server, sonarrTvdbIDs, series)
+	} else if sonarrTvdbIDs != nil {
+		logger.Infof("📺 No series found in non-excluded %s TV libraries", server.Name())
+	}
+}
+
+func (s *Service) processOrphanSeries(ctx context.Context, result *ProcessingResult, cfg *config.Config, dryRun bool, server mediaServer, sonarrTvdbIDs map[int]bool, series []orphanItem) {
+	mediaType, _ := server.Types()
+	queue := s.queues[mediaType]
+
+	logger.Infof("📺 Total %s series fetched: %d", server.Name(), len(series))
+
+	// Filter to orphans only (not in Sonarr)
+	var orphans []orphanItem
+	for _, item := range series {
+		if item.tvdbID == 0 {
+			logger.Warnf("Skipping %s series %q (no TVDB ID)", server.Name(), item.title)
+			continue
+		}
+		if sonarrTvdbIDs[item.tvdbID] {
+			continue
+		}
+		orphans = append(orphans, item)
+	}
+
+	result.IncrementScanned(mediaType, len(orphans))
+	logger.Infof("📺 Found %d orphan series in %s (not in Sonarr)", len(orphans), server.Name())
+
+	if len(orphans) == 0 {
+		return
+	}
+
+	viewers, requireAll := s.viewers(cfg)
+	logger.Infof("👁️  Fetching TV watch history from %s...", sourceName(viewers))
+	if err := loadWatchedShows(ctx, viewers); err != nil {
+		logger.Errorf("❌ Failed to get watched shows: %v", err)
+		return
+	}
+
+	s.checkRewatches(ctx, queue, viewers, cfg, dryRun)
+
+	for _, item := range orphans {
+		res := s.processOneOrphanSeries(ctx, server, item, viewers, requireAll, queue, cfg)
+		if res.ID != 0 {
+			result.AddResult(res)
+		}
+	}
+
+	s.processOrphanRemovalQueue(ctx, result, server, mediaType, queue, cfg, dryRun)
+}
+
+func (s *Service) processOneOrphanSeries(ctx context.Context, server mediaServer, item orphanItem, viewers []*viewer, requireAll bool, queue *Queue, cfg *config.Config) MediaResult {
+	mediaType, _ := server.Types()
+	if item.queueID == 0 {
+		logger.Warnf("Skipping %s series %q (invalid ID: %s)", server.Name(), item.title, item.itemID)
+		return MediaResult{}
+	}
+
+	res := MediaResult{
+		Type:       mediaType,
+		Title:      item.title,
+		ID:         item.queueID,
+		ExternalID: item.tvdbID,
+	}
+
+	if exc := matchExclusion(s.exclusions(cfg), exclusionSubject{title: item.title, tvdbID: item.tvdbID, imdbID: item.imdbID}); exc != "" {
+		res.Action = "skipped"
+		res.Reason = exclusionReason(exc)
+		return res
+	}
+
+	if reason := s.protectedReason(res.Type, res.ExternalID); reason != "" {
+		if err := s.dequeue(ctx, queue, res.ID, reason); err != nil {
+			logger.Warnf("⚠️  Failed to dequeue %s: %v", res.Title, err)
+		}
+		res.Action = "skipped"
+		res.Reason = reason
+		return res
+	}
+
+	rule, skip := orphanRule(queue, &res, item, cfg)
+	if skip {
+		return res
+	}
+
+	if queue.IsQueued(res.ID) {
+		return queuedOrphan(queue, res, cfg)
+	}
+
+	verdict, err := evaluateSeries(ctx, viewers, requireAll, item.tvdbID, func() (map[int]int, error) {
+		return server.SeasonFiles(ctx, item.itemID)
+	})
+	if err != nil {
+		res.Action = "error"
+		res.Reason = err.Error()
+		return res
+	}
+	if !verdict.watched {
+		res.Action = "skipped"
+		res.Reason = verdict.reason
+		return res
+	}
+
+	moreEpisodesComing, ongoingReason := checkOrphanMoreEpisodesComing(verdict.progress, verdict.seasons)
+	if moreEpisodesComing {
+		if queue.IsQueued(res.ID) {
+			if err := queue.Remove(res.ID); err != nil {
+				logger.Warnf("⚠️  Failed to remove %s from queue: %v", res.Title, err)
+			}
+		}
+		res.Action = "skipped"
+		res.Reason = ongoingReason
+		return res
+	}
+
+	watchedReason := fmt.Sprintf("fully watched (via %s)%s", server.Name(), watchedBySuffix(viewers, verdict.watchedBy))
+	return s.queueOrphan(res, item, rule, watchedReason, viewers, queue, cfg)
+}
+
+func (s *Service) processOrphanMovies(ctx context.Context, result *ProcessingResult, cfg *config.Config, dryRun bool, server mediaServer, radarrTmdbIDs map[int]bool, movies []orphanItem) {
+	_, mediaType := server.Types()
+	queue := s.queues[mediaType]
+
+	logger.Infof("🎬 Total %s movies fetched: %d", server.Name(), len(movies))
+
+	var orphans []orphanItem
+	for _, item := range movies {
+		if item.tmdbID == 0 {
+			logger.Warnf("Skipping %s movie %q (no TMDB ID)", server.Name(), item.title)
+			continue
+		}
+		if radarrTmdbIDs[item.tmdbID] {
+			continue
+		}
+		orphans = append(orphans, item)
+	}
+
+	result.IncrementScanned(mediaType, len(orphans))
+	logger.Infof("🎬 Found %d orphan movies in %s (not in Radarr)", len(orphans), server.Name())
+
+	if len(orphans) == 0 {
+		return
+	}
+
+	viewers, requireAll := s.viewers(cfg)
+	logger.Infof("👁️  Fetching movie watch history from %s...", sourceName(viewers))
+	if err := loadWatchedMovies(ctx, viewers); err != nil {
+		logger.Errorf("❌ Failed to get watched movies: %v", err)
+		return
+	}
+
+	s.checkRewatches(ctx, queue, viewers, cfg, dryRun)
+
+	for _, item := range orphans {
+		res := s.processOneOrphanMovie(ctx, server, item, viewers, requireAll, queue, cfg)
+		if res.ID != 0 {
+			result.AddResult(res)
+		}
+	}
+
+	s.processOrphanRemovalQueue(ctx, result, server, mediaType, queue, cfg, dryRun)
+}
+
+func (s *Service) processOneOrphanMovie(ctx context.Context, server mediaServer, item orphanItem, viewers []*viewer, requireAll bool, queue *Queue, cfg *config.Config) MediaResult {
+	_, mediaType := server.Types()
+	if item.queueID == 0 {
+		logger.Warnf("Skipping %s movie %q (invalid ID: %s)", server.Name(), item.title, item.itemID)
+		return MediaResult{}
+	}
+
+	res := MediaResult{
+		Type:       mediaType,
+		Title:      item.title,
+		ID:         item.queueID,
+		ExternalID: item.tmdbID,
+	}
+
+	if exc := matchExclusion(s.exclusions(cfg), exclusionSubject{title: item.title, tmdbID: item.tmdbID, imdbID: item.imdbID}); exc != "" {
+		res.Action = "skipped"
+		res.Reason = exclusionReason(exc)
+		return res
+	}
+
+	if reason := s.protectedReason(res.Type, res.ExternalID); reason != "" {
+		if err := s.dequeue(ctx, queue, res.ID, reason); err != nil {
+			logger.Warnf("⚠️  Failed to dequeue %s: %v", res.Title, err)
+		}
+		res.Action = "skipped"
+		res.Reason = reason
+		return res
+	}
+
+	rule, skip := orphanRule(queue, &res, item, cfg)
+	if skip {
+		return res
+	}
+
+	if queue.IsQueued(res.ID) {
+		return queuedOrphan(queue, res, cfg)
+	}
+
+	verdict := evaluateMovie(viewers, requireAll, item.tmdbID)
+	if !verdict.watched {
+		res.Action = "skipped"
+		res.Reason = verdict.reason
+		return res
+	}
+
+	watchedReason := fmt.Sprintf("watched %s (via %s)%s", verdict.lastWatchedAt.Format("2006-01-02"), server.Name(), watchedBySuffix(viewers, verdict.watchedBy))
+	return s.queueOrphan(res, item, rule, watchedReason, viewers, queue, cfg)
+}
+
+// orphanRule applies the first matching cleanup rule. Orphans have no *arr
+// entry to unmonitor, so "unmonitor" rules keep them as well; skip reports
+// that res has been filled in as skipped.
+func orphanRule(queue *Queue, res *MediaResult, item orphanItem, cfg *config.Config) (rule *config.CleanupRule, skip bool) {
+	rule = matchRule(cfg.Cleanup.Rules, item.subject)
+	if ruleAction(rule) == config.RuleActionDelete {
+		return rule, false
+	}
+
+	if queue.IsQueued(res.ID) {
+		if err := queue.Remove(res.ID); err != nil {
+			logger.Warnf("⚠️  Failed to remove %s from queue: %v", res.Title, err)
+		}
+	}
+	res.Action = "skipped"
+	res.Reason = fmt.Sprintf("never deleted (rule: %s)", ruleName(rule))
+	return rule, true
+}
+
+// queuedOrphan reports an item already in the queue. Items ready for removal
+// return an empty result; they show up as removed instead.
+func queuedOrphan(queue *Queue, res MediaResult, cfg *config.Config) MediaResult {
+	if queue.IsReadyForRemoval(res.ID, ruleDelay(cfg)) {
+		return MediaResult{}
+	}
+	queueItem := queue.Get(res.ID)
+	daysInQueue := int(time.Since(queueItem.MarkedAt).Hours() / 24)
+	daysUntil := itemDelay(cfg, queueItem) - daysInQueue
+	if daysUntil < 0 {
+		daysUntil = 0
+	}
+	res.Action = "queued"
+	res.Reason = queueItem.Reason + " - queued for deletion"
+	res.DaysUntil = daysUntil
+	return res
+}
+
+// queueOrphan queues a watched item for deletion unless a rewatch holds it
+func (s *Service) queueOrphan(res MediaResult, item orphanItem, rule *config.CleanupRule, watchedReason string, viewers []*viewer, queue *Queue, cfg *config.Config) MediaResult {
+	if rule != nil {
+		watchedReason += fmt.Sprintf(" [rule: %s]", ruleName(rule))
+	}
+
+	if held, reason := s.rewatchHeld(res.Type, res.ExternalID, viewers, ruleDelayDays(cfg, rule)); held {
+		res.Action = "skipped"
+		res.Reason = reason
+		return res
+	}
+
+	if err := queue.Add(&QueueItem{
+		ID:         res.ID,
+		ExternalID: res.ExternalID,
+		ItemID:     item.itemID,
+		Title:      item.title,
+		MarkedAt:   time.Now(),
+		Reason:     watchedReason,
+		Rule:       ruleName(rule),
+	}); err != nil {
+		logger.Errorf("❌ Failed to queue %s: %v", res.Title, err)
+		res.Action = "error"
+		res.Reason = fmt.Sprintf("queue save failed: %v", err)
+		return res
+	}
+
+	res.Action = "queued"
+	res.Reason = watchedReason + " - queued for deletion"
+	res.DaysUntil = ruleDelayDays(cfg, rule)
+	return res
+}
+
+func checkOrphanMoreEpisodesComing(progress *trakt.ShowProgress, seasons []trakt.SeasonSummary) (bool, string) {
+	totalEps := make(map[int]int)
+	for _, s := range seasons {
+		totalEps[s.Number] = s.EpisodeCount
+	}
+
+	for _, sp := range progress.Seasons {
+		if sp.Number == 0 {
+			continue
+		}
+		total := totalEps[sp.Number]
+		if total > 0 && sp.Aired < total {
+			return true, fmt.Sprintf("S%02d ongoing (%d/%d aired)", sp.Number, sp.Aired, total)
+		}
+	}
+
+	if progress.NextEpisode != nil {
+		return true, fmt.Sprintf("S%02d ongoing", progress.NextEpisode.Season)
+	}
+
+	return false, ""
+}
+
+func (s *Service) processOrphanRemovalQueue(ctx context.Context, result *ProcessingResult, server mediaServer, mediaType MediaType, queue *Queue, cfg *config.Config, dryRun bool) {
+	ready := queue.GetReadyForRemoval(ruleDelay(cfg))
+	if len(ready) == 0 {
+		return
+	}
+
+	kind := "movies"
+	if series, _ := server.Types(); mediaType == series {
+		kind = "series"
+	}
+	logger.Infof("🗑️  %d %s %s ready for removal", len(ready), server.Name(), kind)
+
+	s.removeOrphanItems(ctx, result, server, mediaType, queue, ready, dryRun)
+}
+
+// removeOrphanItems deletes queued items from the media server and drops
+// them from the queue
+func (s *Service) removeOrphanItems(ctx context.Context, result *ProcessingResult, server mediaServer, mediaType MediaType, queue *Queue, items []*QueueItem, dryRun bool) {
+	for _, item := range items {
+		// Items queued before item IDs were stored are keyed by their numeric ID
+		itemID := item.ItemID
+		if itemID == "" {
+			itemID = strconv.Itoa(item.ID)
+		}
+
+		if dryRun {
+			logger.Warnf("🗑️  [DRY RUN] Would delete from %s: %s", server.Name(), item.Title)
+			result.AddResult(MediaResult{
+				Type:       mediaType,
+				Title:      item.Title,
+				ID:         item.ID,
+				ExternalID: item.ExternalID,
+				Action:     "dry_run_remove",
+				Reason:     "would be deleted",
+			})
+		} else {
+			if err := server.DeleteItem(ctx, itemID); err != nil {
+				logger.Errorf("❌ Failed to delete %s from %s: %v", item.Title, server.Name(), err)
+				result.AddResult(MediaResult{
+					Type:       mediaType,
+					Title:      item.Title,
+					ID:         item.ID,
+					ExternalID: item.ExternalID,
+					Action:     "error",
+					Reason:     fmt.Sprintf("delete failed: %v", err),
+				})
+				continue
+			}
+			logger.Infof("✅ Deleted from %s: %s", server.Name(), item.Title)
+			result.AddResult(MediaResult{
+				Type:       mediaType,
+				Title:      item.Title,
+				ID:         item.ID,
+				ExternalID: item.ExternalID,
+				Action:     "removed",
+				Reason:     "deleted from " + server.Name(),
+			})
+		}
+
+		if err := queue.Remove(item.ID); err != nil {
+			logger.Warnf("⚠️  Failed to remove %s from queue: %v", item.Title, err)
+		}
+	}
+}
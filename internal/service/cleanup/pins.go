@@ -0,0 +1,140 @@
+package cleanup
+
+import (
+	"encoding/json"
+	"fmt"
+	"os"
+	"sort"
+	"sync"
+
+	"github.com/fusionn-air/internal/config"
+	"github.com/fusionn-air/pkg/fileutil"
+)
+
+// Pins persists exclusion entries added through the API. They live next to
+// the queues rather than in config.yaml, which is often mounted read-only.
+type Pins struct {
+	mu       sync.RWMutex
+	entries  map[string]bool
+	filePath string
+}
+
+// NewPinsWithFile creates a new pin list with a specific file path
+func NewPinsWithFile(path string) *Pins {
+	p := &Pins{
+		entries:  make(map[string]bool),
+		filePath: path,
+	}
+	_ = p.load()
+	return p
+}
+
+// Add pins an exclusion entry
+func (p *Pins) Add(entry string) error {
+	p.mu.Lock()
+	defer p.mu.Unlock()
+
+	if p.entries[entry] {
+		return nil
+	}
+
+	p.entries[entry] = true
+	if err := p.save(); err != nil {
+		delete(p.entries, entry)
+		return err
+	}
+	return nil
+}
+
+// Remove unpins an exclusion entry, reporting whether it was pinned
+func (p *Pins) Remove(entry string) (bool, error) {
+	p.mu.Lock()
+	defer p.mu.Unlock()
+
+	if !p.entries[entry] {
+		return false, nil
+	}
+
+	delete(p.entries, entry)
+	if err := p.save(); err != nil {
+		p.entries[entry] = true
+		return false, err
+	}
+	return true, nil
+}
+
+// GetAll returns all pinned entries, sorted
+func (p *Pins) GetAll() []string {
+	p.mu.RLock()
+	defer p.mu.RUnlock()
+
+	entries := make([]string, 0, len(p.entries))
+	for entry := range p.entries {
+		entries = append(entries, entry)
+	}
+	sort.Strings(entries)
+	return entries
+}
+
+// load reads the pin list from disk
+func (p *Pins) load() error {
+	data, err := os.ReadFile(p.filePath)
+	if err != nil {
+		return err
+	}
+
+	var entries []string
+	if err := json.Unmarshal(data, &entries); err != nil {
+		return err
+	}
+
+	for _, entry := range entries {
+		p.entries[entry] = true
+	}
+
+	return nil
+}
+
+// save writes the pin list to disk
+func (p *Pins) save() error {
+	entries := make([]string, 0, len(p.entries))
+	for entry := range p.entries {
+		entries = append(entries, entry)
+	}
+	sort.Strings(entries)
+
+	data, err := json.MarshalIndent(entries, "", "  ")
+	if err != nil {
+		return err
+	}
+
+	return fileutil.WriteAtomic(p.filePath, data)
+}
+
+// pinEntry returns the exclusion entry that pins a queued item
+func pinEntry(item *QueueItem) string {
+	if item.ExternalID == 0 {
+		return item.Title
+	}
+	if item.Type.isSeries() {
+		return fmt.Sprintf("%s%d", exclusionTVDB, item.ExternalID)
+	}
+	return fmt.Sprintf("%s%d", exclusionTMDB, item.ExternalID)
+}
+
+// exclusions returns the configured exclusions plus pinned entries
+func (s *Service) exclusions(cfg *config.Config) []string {
+	pinned := s.pins.GetAll()
+	if len(pinned) == 0 {
+		return cfg.Cleanup.Exclusions
+	}
+
+	all := make([]string, 0, len(cfg.Cleanup.Exclusions)+len(pinned))
+	all = append(all, cfg.Cleanup.Exclusions...)
+	for _, entry := range pinned {
+		if !anyEqualFold(cfg.Cleanup.Exclusions, entry) {
+			all = append(all, entry)
+		}
+	}
+	return all
+}
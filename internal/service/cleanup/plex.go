@@ -0,0 +1,133 @@
+package cleanup
+
+import (
+	"context"
+	"fmt"
+	"strconv"
+
+	"github.com/fusionn-air/internal/client/plex"
+	"github.com/fusionn-air/internal/config"
+	"github.com/fusionn-air/pkg/logger"
+)
+
+// plexServer adapts Plex to the orphan processor. Items are queued by their
+// numeric rating key.
+type plexServer struct {
+	client *plex.Client
+}
+
+func (p *plexServer) Name() string { return "Plex" }
+
+func (p *plexServer) Enabled(cfg *config.Config) bool { return cfg.Plex.Enabled }
+
+func (p *plexServer) Types() (series, movies MediaType) {
+	return MediaTypePlexSeries, MediaTypePlexMovie
+}
+
+// Libraries collects series and movies across non-excluded Plex library sections
+func (p *plexServer) Libraries(ctx context.Context, cfg *config.Config, wantSeries, wantMovies bool) (series, movies []orphanItem) {
+	sections, err := p.client.GetSections(ctx)
+	if err != nil {
+		logger.Errorf("❌ Failed to fetch Plex library sections: %v", err)
+		return nil, nil
+	}
+
+	names := make([]string, len(sections))
+	for i, sec := range sections {
+		names[i] = sec.Title
+	}
+	excludedLibNames := excludedLibrarySet("Plex", names, cfg.Plex.ExcludedLibraries)
+
+	for _, sec := range sections {
+		if excludedLibNames[sec.Title] {
+			logger.Infof("📚 Skipping excluded Plex library %q (key: %s)", sec.Title, sec.Key)
+			continue
+		}
+
+		switch sec.Type {
+		case plex.SectionTypeMovie:
+			if !wantMovies {
+				logger.Warnf("⚠️  Skipping Plex movie library %q - Radarr data unavailable", sec.Title)
+				continue
+			}
+			items, err := p.client.GetItems(ctx, sec.Key)
+			if err != nil {
+				logger.Errorf("❌ Failed to get movies from Plex library %q: %v", sec.Title, err)
+				continue
+			}
+			logger.Infof("🎬 Found %d movies in Plex library %q", len(items), sec.Title)
+			for _, item := range items {
+				movies = append(movies, plexOrphan(item, sec.Title))
+			}
+
+		case plex.SectionTypeShow:
+			if !wantSeries {
+				logger.Warnf("⚠️  Skipping Plex TV library %q - Sonarr data unavailable", sec.Title)
+				continue
+			}
+			items, err := p.client.GetItems(ctx, sec.Key)
+			if err != nil {
+				logger.Errorf("❌ Failed to get series from Plex library %q: %v", sec.Title, err)
+				continue
+			}
+			logger.Infof("📺 Found %d series in Plex library %q", len(items), sec.Title)
+			for _, item := range items {
+				series = append(series, plexOrphan(item, sec.Title))
+			}
+
+		default:
+			logger.Debugf("📚 Skipping Plex library %q (unsupported type: %s)", sec.Title, sec.Type)
+		}
+	}
+
+	return series, movies
+}
+
+// plexOrphan converts a Plex item; invalid rating keys leave queueID 0
+func plexOrphan(item plex.Metadata, library string) orphanItem {
+	id, _ := strconv.Atoi(item.RatingKey)
+	guids := item.ExternalGUIDs()
+	return orphanItem{
+		queueID: id,
+		itemID:  item.RatingKey,
+		title:   item.Title,
+		tvdbID:  plex.ParseGUID(guids, "tvdb"),
+		tmdbID:  plex.ParseGUID(guids, "tmdb"),
+		imdbID:  plex.GUIDValue(guids, "imdb"),
+		subject: plexSubject(item, library),
+	}
+}
+
+// SeasonFiles returns the number of episodes with files per season
+func (p *plexServer) SeasonFiles(ctx context.Context, seriesID string) (map[int]int, error) {
+	seasons, err := p.client.GetSeasons(ctx, seriesID)
+	if err != nil {
+		return nil, fmt.Errorf("getting Plex seasons: %w", err)
+	}
+
+	files := make(map[int]int)
+	for _, season := range seasons {
+		seasonNum := season.Index
+		if seasonNum == 0 {
+			continue
+		}
+
+		episodes, err := p.client.GetEpisodes(ctx, season.RatingKey)
+		if err != nil {
+			logger.Warnf("Failed to get Plex episodes for season %d: %v", seasonNum, err)
+			continue
+		}
+
+		for _, ep := range episodes {
+			if len(ep.Media) > 0 {
+				files[seasonNum]++
+			}
+		}
+	}
+
+	return files, nil
+}
+
+func (p *plexServer) DeleteItem(ctx context.Context, ratingKey string) error {
+	return p.client.DeleteItem(ctx, ratingKey)
+}
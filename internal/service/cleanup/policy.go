@@ -0,0 +1,376 @@
+package cleanup
+
+import (
+	"context"
+	"fmt"
+	"sort"
+	"strings"
+	"time"
+
+	"github.com/fusionn-air/internal/client/trakt"
+	"github.com/fusionn-air/internal/config"
+	"github.com/fusionn-air/pkg/logger"
+)
+
+// Watch policy modes
+const (
+	PolicyAll   = "all"   // Every viewer must have watched
+	PolicyAny   = "any"   // One viewer having watched is enough
+	PolicyUsers = "users" // Every named viewer must have watched
+)
+
+const primaryViewerName = "me"
+
+// viewer is one person whose watch history counts towards cleanup decisions
+type viewer struct {
+	name    string
+	history WatchHistory
+	shows   map[int]*trakt.WatchedShow  // by TVDB, filled by loadWatchedShows
+	movies  map[int]*trakt.WatchedMovie // by TMDB, filled by loadWatchedMovies
+}
+
+// viewers returns the viewers the watch policy evaluates for this run, plus
+// whether all of them (true) or any of them (false) must have watched.
+func (s *Service) viewers(cfg *config.Config) ([]*viewer, bool) {
+	primary := s.watchHistory(cfg)
+	if _, isTrakt := primary.(*traktHistory); !isTrakt {
+		// Additional accounts are Trakt-only; other providers have a single viewer
+		return []*viewer{{name: primary.Name(), history: primary}}, true
+	}
+
+	primaryName := cfg.Trakt.Name
+	if primaryName == "" {
+		primaryName = primaryViewerName
+	}
+
+	all := []*viewer{{name: primaryName, history: primary}}
+	for _, c := range s.traktUsers {
+		all = append(all, &viewer{name: c.Name(), history: &traktHistory{client: c}})
+	}
+
+	policy := cfg.Cleanup.WatchPolicy
+	switch strings.ToLower(policy.Mode) {
+	case "", PolicyAll:
+		return all, true
+	case PolicyAny:
+		return all, false
+	case PolicyUsers:
+		var named []*viewer
+		for _, name := range policy.Users {
+			found := false
+			for _, v := range all {
+				if strings.EqualFold(v.name, name) {
+					named = append(named, v)
+					found = true
+					break
+				}
+			}
+			if !found {
+				logger.Warnf("⚠️  Watch policy user %q is not a configured Trakt account", name)
+			}
+		}
+		if len(named) == 0 {
+			logger.Warnf("⚠️  Watch policy mode users has no known users — using %s only", primaryName)
+			return all[:1], true
+		}
+		return named, true
+	default:
+		logger.Warnf("⚠️  Unknown watch policy mode %q — requiring all users", policy.Mode)
+		return all, true
+	}
+}
+
+// sourceName describes where watch history comes from, for log lines
+func sourceName(viewers []*viewer) string {
+	if len(viewers) == 1 {
+		return viewers[0].history.Name()
+	}
+	names := make([]string, len(viewers))
+	for i, v := range viewers {
+		names[i] = v.name
+	}
+	return fmt.Sprintf("%s (%s)", viewers[0].history.Name(), strings.Join(names, ", "))
+}
+
+// loadWatchedShows fetches every viewer's watched shows, indexed by TVDB ID
+func loadWatchedShows(ctx context.Context, viewers []*viewer) error {
+	for _, v := range viewers {
+		shows, err := v.history.GetWatchedShows(ctx)
+		if err != nil {
+			return fmt.Errorf("%s: %w", v.name, err)
+		}
+		v.shows = make(map[int]*trakt.WatchedShow)
+		for i := range shows {
+			if shows[i].Show.IDs.TVDB > 0 {
+				v.shows[shows[i].Show.IDs.TVDB] = &shows[i]
+			}
+		}
+	}
+	return nil
+}
+
+// loadWatchedMovies fetches every viewer's watched movies, indexed by TMDB ID
+func loadWatchedMovies(ctx context.Context, viewers []*viewer) error {
+	for _, v := range viewers {
+		movies, err := v.history.GetWatchedMovies(ctx)
+		if err != nil {
+			return fmt.Errorf("%s: %w", v.name, err)
+		}
+		v.movies = make(map[int]*trakt.WatchedMovie)
+		for i := range movies {
+			if movies[i].Movie.IDs.TMDB > 0 {
+				v.movies[movies[i].Movie.IDs.TMDB] = &movies[i]
+			}
+		}
+	}
+	return nil
+}
+
+// seriesVerdict is the watch policy outcome for one series
+type seriesVerdict struct {
+	watched   bool
+	reason    string // Skip reason when not watched
+	watchedBy []string
+	progress  *trakt.ShowProgress // From a viewer who has watched (for airing checks)
+	seasons   []trakt.SeasonSummary
+}
+
+// evaluateSeries applies the watch policy to a series. filesBySeason is only
+// called once a viewer has history for the show, so media servers aren't
+// queried for shows nobody watched.
+func evaluateSeries(ctx context.Context, viewers []*viewer, requireAll bool, tvdbID int, filesBySeason func() (map[int]int, error)) (seriesVerdict, error) {
+	var verdict seriesVerdict
+	var files map[int]int
+	var firstReason string
+
+	for _, v := range viewers {
+		reason, progress, seasons, err := func() (string, *trakt.ShowProgress, []trakt.SeasonSummary, error) {
+			watched, found := v.shows[tvdbID]
+			if !found {
+				return "no watch history", nil, nil, nil
+			}
+
+			progress, err := v.history.GetShowProgress(ctx, watched.Show)
+			if err != nil {
+				return "", nil, nil, fmt.Errorf("%s error: %w", strings.ToLower(v.history.Name()), err)
+			}
+
+			// Get season info for total episode counts
+			seasons, _ := v.history.GetShowSeasons(ctx, watched.Show)
+
+			if files == nil {
+				if files, err = filesBySeason(); err != nil {
+					return "", nil, nil, err
+				}
+			}
+
+			watchedOnDisk, unwatchedSeasons := checkSeasonFilesWatched(files, progress)
+			if !watchedOnDisk {
+				return buildWatchingReason(progress, seasons, unwatchedSeasons), progress, seasons, nil
+			}
+			return "", progress, seasons, nil
+		}()
+		if err != nil {
+			return verdict, err
+		}
+
+		if len(viewers) > 1 && reason != "" {
+			reason = fmt.Sprintf("%s: %s", v.name, reason)
+		}
+
+		if reason == "" {
+			verdict.watchedBy = append(verdict.watchedBy, v.name)
+			if verdict.progress == nil {
+				verdict.progress = progress
+				verdict.seasons = seasons
+			}
+			if !requireAll {
+				break
+			}
+			continue
+		}
+
+		if firstReason == "" {
+			firstReason = reason
+		}
+		if requireAll {
+			verdict.reason = reason
+			return verdict, nil
+		}
+	}
+
+	if len(verdict.watchedBy) == 0 {
+		verdict.reason = firstReason
+		return verdict, nil
+	}
+
+	verdict.watched = true
+	return verdict, nil
+}
+
+// watchedSeasons returns the seasons of filesBySeason that the watch policy
+// considers fully watched (at least as many episodes completed as on disk)
+func watchedSeasons(ctx context.Context, viewers []*viewer, requireAll bool, tvdbID int, filesBySeason map[int]int) ([]int, error) {
+	watchedBy := make(map[int]int)
+	for _, v := range viewers {
+		watched, found := v.shows[tvdbID]
+		if !found {
+			if requireAll {
+				return nil, nil
+			}
+			continue
+		}
+
+		progress, err := v.history.GetShowProgress(ctx, watched.Show)
+		if err != nil {
+			return nil, fmt.Errorf("%s error: %w", strings.ToLower(v.history.Name()), err)
+		}
+
+		completed := make(map[int]int)
+		for _, sp := range progress.Seasons {
+			completed[sp.Number] = sp.Completed
+		}
+		for season, files := range filesBySeason {
+			if files > 0 && completed[season] >= files {
+				watchedBy[season]++
+			}
+		}
+	}
+
+	need := 1
+	if requireAll {
+		need = len(viewers)
+	}
+
+	var seasons []int
+	for season, count := range watchedBy {
+		if count >= need {
+			seasons = append(seasons, season)
+		}
+	}
+	sort.Ints(seasons)
+	return seasons, nil
+}
+
+// episodeKey identifies an episode by season and episode number
+type episodeKey struct {
+	season  int
+	episode int
+}
+
+// watchedEpisodes returns the episodes of a show the watch policy considers
+// watched (completed by every viewer, or by any viewer)
+func watchedEpisodes(ctx context.Context, viewers []*viewer, requireAll bool, tvdbID int) (map[episodeKey]bool, error) {
+	watchedBy := make(map[episodeKey]int)
+	for _, v := range viewers {
+		watched, found := v.shows[tvdbID]
+		if !found {
+			if requireAll {
+				return nil, nil
+			}
+			continue
+		}
+
+		progress, err := v.history.GetShowProgress(ctx, watched.Show)
+		if err != nil {
+			return nil, fmt.Errorf("%s error: %w", strings.ToLower(v.history.Name()), err)
+		}
+
+		for _, sp := range progress.Seasons {
+			for _, ep := range sp.Episodes {
+				if ep.Completed {
+					watchedBy[episodeKey{season: sp.Number, episode: ep.Number}]++
+				}
+			}
+		}
+	}
+
+	need := 1
+	if requireAll {
+		need = len(viewers)
+	}
+
+	episodes := make(map[episodeKey]bool)
+	for key, count := range watchedBy {
+		if count >= need {
+			episodes[key] = true
+		}
+	}
+	return episodes, nil
+}
+
+// movieVerdict is the watch policy outcome for one movie
+type movieVerdict struct {
+	watched       bool
+	reason        string // Skip reason when not watched
+	watchedBy     []string
+	lastWatchedAt time.Time
+}
+
+// evaluateMovie applies the watch policy to a movie
+func evaluateMovie(viewers []*viewer, requireAll bool, tmdbID int) movieVerdict {
+	var verdict movieVerdict
+	var missing []string
+
+	for _, v := range viewers {
+		watched, found := v.movies[tmdbID]
+		if !found {
+			missing = append(missing, v.name)
+			continue
+		}
+		verdict.watchedBy = append(verdict.watchedBy, v.name)
+		if watched.LastWatchedAt.After(verdict.lastWatchedAt) {
+			verdict.lastWatchedAt = watched.LastWatchedAt
+		}
+	}
+
+	switch {
+	case len(verdict.watchedBy) == 0:
+		verdict.reason = "not watched"
+	case requireAll && len(missing) > 0:
+		verdict.reason = fmt.Sprintf("not watched by %s", strings.Join(missing, ", "))
+	default:
+		verdict.watched = true
+	}
+
+	return verdict
+}
+
+// watchedBySuffix names who watched when more than one viewer is evaluated
+func watchedBySuffix(viewers []*viewer, watchedBy []string) string {
+	if len(viewers) <= 1 {
+		return ""
+	}
+	return " by " + strings.Join(watchedBy, ", ")
+}
+
+// checkSeasonFilesWatched checks whether every season with files on disk has
+// at least that many episodes completed. Specials (S00) are ignored.
+func checkSeasonFilesWatched(filesBySeason map[int]int, progress *trakt.ShowProgress) (bool, []int) {
+	var unwatchedSeasons []int
+
+	traktProgress := make(map[int]*trakt.SeasonProgress)
+	for i := range progress.Seasons {
+		traktProgress[progress.Seasons[i].Number] = &progress.Seasons[i]
+	}
+
+	seasonNums := make([]int, 0, len(filesBySeason))
+	for num := range filesBySeason {
+		seasonNums = append(seasonNums, num)
+	}
+	sort.Ints(seasonNums)
+
+	for _, seasonNum := range seasonNums {
+		files := filesBySeason[seasonNum]
+		if seasonNum == 0 || files == 0 {
+			continue
+		}
+
+		traktSeason, found := traktProgress[seasonNum]
+		if !found || traktSeason.Completed < files {
+			unwatchedSeasons = append(unwatchedSeasons, seasonNum)
+		}
+	}
+
+	return len(unwatchedSeasons) == 0, unwatchedSeasons
+}
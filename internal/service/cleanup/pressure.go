@@ -0,0 +1,169 @@
+package cleanup
+
+import (
+	"context"
+	"sort"
+	"strings"
+
+	"github.com/fusionn-air/internal/client/sonarr"
+	"github.com/fusionn-air/internal/config"
+	"github.com/fusionn-air/pkg/logger"
+)
+
+const bytesPerGB = 1 << 30
+
+// rootFolderSpace is the free space of one Sonarr/Radarr root folder
+type rootFolderSpace struct {
+	Path      string
+	FreeSpace int64
+}
+
+// sonarrRootFolders returns the free space of Sonarr's root folders
+func (s *Service) sonarrRootFolders(ctx context.Context) ([]rootFolderSpace, error) {
+	folders, err := s.sonarr.GetRootFolders(ctx)
+	if err != nil {
+		return nil, err
+	}
+	spaces := make([]rootFolderSpace, 0, len(folders))
+	for _, f := range folders {
+		if f.Accessible {
+			spaces = append(spaces, rootFolderSpace{Path: f.Path, FreeSpace: f.FreeSpace})
+		}
+	}
+	return spaces, nil
+}
+
+// radarrRootFolders returns the free space of Radarr's root folders
+func (s *Service) radarrRootFolders(ctx context.Context) ([]rootFolderSpace, error) {
+	folders, err := s.radarr.GetRootFolders(ctx)
+	if err != nil {
+		return nil, err
+	}
+	spaces := make([]rootFolderSpace, 0, len(folders))
+	for _, f := range folders {
+		if f.Accessible {
+			spaces = append(spaces, rootFolderSpace{Path: f.Path, FreeSpace: f.FreeSpace})
+		}
+	}
+	return spaces, nil
+}
+
+// diskPressureRemovals returns queued items to remove ahead of their delay
+// because their root folder is below cleanup.disk_pressure.min_free_gb.
+// Items in due are already being removed and count towards the target.
+func (s *Service) diskPressureRemovals(ctx context.Context, app string, rootFolders func(context.Context) ([]rootFolderSpace, error), queue *Queue, due []*QueueItem, cfg *config.Config) []*QueueItem {
+	pressure := cfg.Cleanup.DiskPressure
+	if !pressure.Enabled || pressure.MinFreeGB <= 0 {
+		return nil
+	}
+
+	folders, err := rootFolders(ctx)
+	if err != nil {
+		logger.Warnf("⚠️  Failed to get %s free space: %v — skipping disk pressure check", app, err)
+		return nil
+	}
+
+	minFree := int64(pressure.MinFreeGB * bytesPerGB)
+	targetFree := int64(pressure.TargetFreeGB * bytesPerGB)
+	if targetFree < minFree {
+		targetFree = minFree
+	}
+
+	for _, f := range folders {
+		if f.FreeSpace < minFree {
+			logger.Warnf("💾 %s root folder %s low on space: %s free (min %s)",
+				app, f.Path, sonarr.FormatSize(f.FreeSpace), sonarr.FormatSize(minFree))
+		}
+	}
+
+	early := selectForDiskPressure(folders, freesSpace(queue.GetAll(), cfg), freesSpace(due, cfg), minFree, targetFree)
+	if len(early) == 0 {
+		return nil
+	}
+
+	var freed int64
+	for _, item := range early {
+		freed += item.SizeOnDisk
+	}
+	logger.Infof("💾 Removing %d queued %s item(s) early to free %s", len(early), app, sonarr.FormatSize(freed))
+
+	return early
+}
+
+// freesSpace drops items whose rule keeps their files
+func freesSpace(items []*QueueItem, cfg *config.Config) []*QueueItem {
+	var deletable []*QueueItem
+	for _, item := range items {
+		if ruleAction(ruleByName(cfg.Cleanup.Rules, item.Rule)) != config.RuleActionUnmonitor {
+			deletable = append(deletable, item)
+		}
+	}
+	return deletable
+}
+
+// selectForDiskPressure picks queued items (largest SizeOnDisk first, then
+// oldest MarkedAt) until every root folder below minFree reaches targetFree.
+func selectForDiskPressure(folders []rootFolderSpace, queued, due []*QueueItem, minFree, targetFree int64) []*QueueItem {
+	needed := make(map[string]int64)
+	for _, f := range folders {
+		if f.FreeSpace < minFree {
+			needed[f.Path] = targetFree - f.FreeSpace
+		}
+	}
+	if len(needed) == 0 {
+		return nil
+	}
+
+	// Items already due free space without jumping the queue
+	dueIDs := make(map[int]bool, len(due))
+	for _, item := range due {
+		dueIDs[item.ID] = true
+		needed[rootFolderFor(folders, item.Path)] -= item.SizeOnDisk
+	}
+
+	candidates := make([]*QueueItem, 0, len(queued))
+	for _, item := range queued {
+		if !dueIDs[item.ID] && item.SizeOnDisk > 0 {
+			candidates = append(candidates, item)
+		}
+	}
+	sort.Slice(candidates, func(i, j int) bool {
+		if candidates[i].SizeOnDisk != candidates[j].SizeOnDisk {
+			return candidates[i].SizeOnDisk > candidates[j].SizeOnDisk
+		}
+		return candidates[i].MarkedAt.Before(candidates[j].MarkedAt)
+	})
+
+	var selected []*QueueItem
+	for _, item := range candidates {
+		root := rootFolderFor(folders, item.Path)
+		if needed[root] <= 0 {
+			continue
+		}
+		selected = append(selected, item)
+		needed[root] -= item.SizeOnDisk
+	}
+
+	return selected
+}
+
+// rootFolderFor returns the root folder containing path (longest match).
+// Items queued before paths were recorded fall back to a sole root folder.
+func rootFolderFor(folders []rootFolderSpace, path string) string {
+	if path == "" {
+		if len(folders) == 1 {
+			return folders[0].Path
+		}
+		return ""
+	}
+
+	var best string
+	for _, f := range folders {
+		root := strings.TrimRight(f.Path, `/\`)
+		inside := path == root || strings.HasPrefix(path, root+"/") || strings.HasPrefix(path, root+`\`)
+		if inside && len(f.Path) > len(best) {
+			best = f.Path
+		}
+	}
+	return best
+}
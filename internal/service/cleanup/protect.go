@@ -0,0 +1,133 @@
+package cleanup
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/fusionn-air/internal/client/trakt"
+	"github.com/fusionn-air/internal/config"
+	"github.com/fusionn-air/pkg/logger"
+)
+
+// protectedSet is every show and movie on a protected Trakt watchlist or
+// list, with where it was found
+type protectedSet struct {
+	shows  map[int]string // TVDB ID → reason
+	movies map[int]string // TMDB ID → reason
+}
+
+func (p *protectedSet) add(item trakt.ListItem, reason string) {
+	switch {
+	case item.Movie != nil:
+		if id := item.Movie.IDs.TMDB; id > 0 {
+			if _, exists := p.movies[id]; !exists {
+				p.movies[id] = reason
+			}
+		}
+	case item.Show != nil:
+		// Seasons and episodes protect their whole show
+		if id := item.Show.IDs.TVDB; id > 0 {
+			if _, exists := p.shows[id]; !exists {
+				p.shows[id] = reason
+			}
+		}
+	}
+}
+
+// refreshProtected reloads the protected watchlists and lists for this run.
+// An error means protection is configured but couldn't be loaded, and
+// nothing should be removed until it can.
+func (s *Service) refreshProtected(ctx context.Context, cfg *config.Config) error {
+	protect := cfg.Cleanup.Protect
+	if !protect.Watchlist && len(protect.Lists) == 0 {
+		s.mu.Lock()
+		s.protected = nil
+		s.mu.Unlock()
+		return nil
+	}
+
+	set, err := s.loadProtected(ctx, cfg)
+	if err != nil {
+		return err
+	}
+
+	logger.Infof("🛡️  Protected by Trakt lists: %d shows, %d movies", len(set.shows), len(set.movies))
+	s.mu.Lock()
+	s.protected = set
+	s.mu.Unlock()
+	return nil
+}
+
+// loadProtected fetches the watchlist and protected lists of every Trakt account
+func (s *Service) loadProtected(ctx context.Context, cfg *config.Config) (*protectedSet, error) {
+	set := &protectedSet{shows: make(map[int]string), movies: make(map[int]string)}
+	protect := cfg.Cleanup.Protect
+
+	primaryName := cfg.Trakt.Name
+	if primaryName == "" {
+		primaryName = primaryViewerName
+	}
+
+	type account struct {
+		name   string
+		client *trakt.Client
+	}
+	var accounts []account
+	if s.trakt != nil {
+		accounts = append(accounts, account{name: primaryName, client: s.trakt})
+	}
+	for _, c := range s.traktUsers {
+		accounts = append(accounts, account{name: c.Name(), client: c})
+	}
+
+	for _, acc := range accounts {
+		if protect.Watchlist {
+			items, err := acc.client.GetWatchlist(ctx)
+			if err != nil {
+				return nil, fmt.Errorf("%s watchlist: %w", acc.name, err)
+			}
+			reason := fmt.Sprintf("on Trakt watchlist (%s)", acc.name)
+			for _, item := range items {
+				set.add(item, reason)
+			}
+		}
+
+		if len(protect.Lists) == 0 {
+			continue
+		}
+
+		lists, err := acc.client.GetLists(ctx)
+		if err != nil {
+			return nil, fmt.Errorf("%s lists: %w", acc.name, err)
+		}
+		for _, list := range lists {
+			if !anyEqualFold(protect.Lists, "*", list.IDs.Slug, list.Name) {
+				continue
+			}
+			items, err := acc.client.GetListItems(ctx, list.IDs.Slug)
+			if err != nil {
+				return nil, fmt.Errorf("%s list %s: %w", acc.name, list.IDs.Slug, err)
+			}
+			reason := fmt.Sprintf("in Trakt list %s (%s)", list.Name, acc.name)
+			for _, item := range items {
+				set.add(item, reason)
+			}
+		}
+	}
+
+	return set, nil
+}
+
+// protectedReason returns why an item is protected by a Trakt list, or ""
+func (s *Service) protectedReason(mediaType MediaType, externalID int) string {
+	s.mu.RLock()
+	defer s.mu.RUnlock()
+
+	if s.protected == nil || externalID == 0 {
+		return ""
+	}
+	if mediaType.isSeries() {
+		return s.protected.shows[externalID]
+	}
+	return s.protected.movies[externalID]
+}
@@ -1,60 +1,92 @@
 package cleanup
 
 import (
-	"encoding/json"
-	"os"
-	"path/filepath"
+	"fmt"
 	"sync"
 	"time"
 )
 
 // QueueItem represents any media item marked for removal
 type QueueItem struct {
-	ID            int        `json:"id"`          // Sonarr/Radarr/etc ID
-	ExternalID    int        `json:"external_id"` // TVDB for shows, TMDB for movies
+	Type          MediaType  `json:"type"`
+	ID            int        `json:"id"`                // Sonarr/Radarr/etc ID
+	ExternalID    int        `json:"external_id"`       // TVDB for shows, TMDB for movies
+	ItemID        string     `json:"item_id,omitempty"` // Media server item ID (older Emby/Plex entries: empty, use ID)
 	Title         string     `json:"title"`
 	MarkedAt      time.Time  `json:"marked_at"`
 	UnmonitoredAt *time.Time `json:"unmonitored_at,omitempty"` // When item was unmonitored
 	Reason        string     `json:"reason"`
 	SizeOnDisk    int64      `json:"size_on_disk"`
+	Path          string     `json:"path,omitempty"`  // Sonarr/Radarr folder, matched to root folders under disk pressure
+	Rule          string     `json:"rule,omitempty"`  // Cleanup rule matched when queued
+	Plays         int        `json:"plays,omitempty"` // Watch plays when last checked, for rewatch detection
+
+	// Monitoring before the item was unmonitored, restored if it leaves the queue undeleted
+	MonitorState *MonitorState `json:"monitor_state,omitempty"`
+}
+
+// MonitorState is an item's Sonarr/Radarr monitoring at queue time
+type MonitorState struct {
+	Monitored bool         `json:"monitored"`
+	Seasons   map[int]bool `json:"seasons,omitempty"` // Season number → monitored (series only)
 }
 
-// Queue manages the cleanup queue persistence
+// Queue is the cleanup queue for one media type. Every change is written to
+// the store before it's applied, so a failed save leaves the queue unchanged.
 type Queue struct {
-	mu       sync.RWMutex
-	items    map[int]*QueueItem // keyed by ID
-	filePath string
+	mu        sync.RWMutex
+	items     map[int]*QueueItem // keyed by ID
+	mediaType MediaType
+	store     QueueStore
 }
 
-// NewQueueWithFile creates a new queue with a specific file path
-func NewQueueWithFile(path string) *Queue {
+// NewQueue loads a media type's queue from the store
+func NewQueue(mediaType MediaType, store QueueStore) (*Queue, error) {
 	q := &Queue{
-		items:    make(map[int]*QueueItem),
-		filePath: path,
+		items:     make(map[int]*QueueItem),
+		mediaType: mediaType,
+		store:     store,
+	}
+
+	items, err := store.Load(mediaType)
+	if err != nil {
+		return nil, fmt.Errorf("loading %s queue: %w", mediaType, err)
+	}
+	for _, item := range items {
+		item.Type = mediaType
+		q.items[item.ID] = item
 	}
-	_ = q.load()
-	return q
+	return q, nil
 }
 
 // Add adds an item to the queue
-func (q *Queue) Add(item *QueueItem) {
+func (q *Queue) Add(item *QueueItem) error {
 	q.mu.Lock()
 	defer q.mu.Unlock()
 
 	// Don't overwrite if already in queue (preserve original marked time)
-	if _, exists := q.items[item.ID]; !exists {
-		q.items[item.ID] = item
-		_ = q.save()
+	if _, exists := q.items[item.ID]; exists {
+		return nil
 	}
+
+	item.Type = q.mediaType
+	return q.commit(func(items map[int]*QueueItem) {
+		items[item.ID] = item
+	})
 }
 
 // Remove removes an item from the queue
-func (q *Queue) Remove(id int) {
+func (q *Queue) Remove(id int) error {
 	q.mu.Lock()
 	defer q.mu.Unlock()
 
-	delete(q.items, id)
-	_ = q.save()
+	if _, exists := q.items[id]; !exists {
+		return nil
+	}
+
+	return q.commit(func(items map[int]*QueueItem) {
+		delete(items, id)
+	})
 }
 
 // Get returns a queue item by ID
@@ -66,15 +98,45 @@ func (q *Queue) Get(id int) *QueueItem {
 }
 
 // MarkUnmonitored sets the unmonitored timestamp for a queue item
-func (q *Queue) MarkUnmonitored(id int) {
+func (q *Queue) MarkUnmonitored(id int) error {
 	q.mu.Lock()
 	defer q.mu.Unlock()
 
-	if item, exists := q.items[id]; exists {
+	item, exists := q.items[id]
+	if !exists {
+		return nil
+	}
+
+	return q.commit(func(items map[int]*QueueItem) {
+		updated := *item
 		now := time.Now()
-		item.UnmonitoredAt = &now
-		_ = q.save()
+		updated.UnmonitoredAt = &now
+		items[id] = &updated
+	})
+}
+
+// Reschedule moves an item's marked time, which shifts when it's removed
+func (q *Queue) Reschedule(id int, markedAt time.Time) error {
+	return q.Update(id, func(item *QueueItem) {
+		item.MarkedAt = markedAt
+	})
+}
+
+// Update applies change to a copy of a queued item and saves it
+func (q *Queue) Update(id int, change func(item *QueueItem)) error {
+	q.mu.Lock()
+	defer q.mu.Unlock()
+
+	item, exists := q.items[id]
+	if !exists {
+		return nil
 	}
+
+	updated := *item
+	change(&updated)
+	return q.commit(func(items map[int]*QueueItem) {
+		items[id] = &updated
+	})
 }
 
 // GetAll returns all items in the queue
@@ -89,17 +151,14 @@ func (q *Queue) GetAll() []*QueueItem {
 	return items
 }
 
-// GetReadyForRemoval returns items that have passed the delay period
-func (q *Queue) GetReadyForRemoval(delayDays int) []*QueueItem {
+// GetReadyForRemoval returns items that have passed their delay period
+func (q *Queue) GetReadyForRemoval(delayDays func(*QueueItem) int) []*QueueItem {
 	q.mu.RLock()
 	defer q.mu.RUnlock()
 
-	delay := time.Duration(delayDays) * 24 * time.Hour
-	cutoff := time.Now().Add(-delay)
-
 	var ready []*QueueItem
 	for _, item := range q.items {
-		if item.MarkedAt.Before(cutoff) {
+		if delayPassed(item, delayDays(item)) {
 			ready = append(ready, item)
 		}
 	}
@@ -116,7 +175,7 @@ func (q *Queue) IsQueued(id int) bool {
 }
 
 // IsReadyForRemoval checks if a specific item is ready for removal
-func (q *Queue) IsReadyForRemoval(id, delayDays int) bool {
+func (q *Queue) IsReadyForRemoval(id int, delayDays func(*QueueItem) int) bool {
 	q.mu.RLock()
 	defer q.mu.RUnlock()
 
@@ -125,55 +184,43 @@ func (q *Queue) IsReadyForRemoval(id, delayDays int) bool {
 		return false
 	}
 
+	return delayPassed(item, delayDays(item))
+}
+
+// delayPassed checks if an item has been queued for longer than delayDays
+func delayPassed(item *QueueItem, delayDays int) bool {
 	delay := time.Duration(delayDays) * 24 * time.Hour
 	cutoff := time.Now().Add(-delay)
 	return item.MarkedAt.Before(cutoff)
 }
 
-// load reads the queue from disk
-func (q *Queue) load() error {
-	data, err := os.ReadFile(q.filePath)
-	if err != nil {
-		return err
-	}
-
-	var items []*QueueItem
-	if err := json.Unmarshal(data, &items); err != nil {
-		return err
-	}
-
-	for _, item := range items {
-		q.items[item.ID] = item
-	}
+// Clear removes all items from the queue
+func (q *Queue) Clear() error {
+	q.mu.Lock()
+	defer q.mu.Unlock()
 
-	return nil
+	return q.commit(func(items map[int]*QueueItem) {
+		clear(items)
+	})
 }
 
-// save writes the queue to disk
-func (q *Queue) save() error {
-	dir := filepath.Dir(q.filePath)
-	if err := os.MkdirAll(dir, 0o755); err != nil {
-		return err
+// commit applies change to a copy of the items, saves it, and only then
+// swaps it in. Callers must hold q.mu.
+func (q *Queue) commit(change func(map[int]*QueueItem)) error {
+	next := make(map[int]*QueueItem, len(q.items)+1)
+	for id, item := range q.items {
+		next[id] = item
 	}
+	change(next)
 
-	items := make([]*QueueItem, 0, len(q.items))
-	for _, item := range q.items {
-		items = append(items, item)
+	list := make([]*QueueItem, 0, len(next))
+	for _, item := range next {
+		list = append(list, item)
 	}
-
-	data, err := json.MarshalIndent(items, "", "  ")
-	if err != nil {
-		return err
+	if err := q.store.Save(q.mediaType, list); err != nil {
+		return fmt.Errorf("saving %s queue: %w", q.mediaType, err)
 	}
 
-	return os.WriteFile(q.filePath, data, 0o600)
-}
-
-// Clear removes all items from the queue
-func (q *Queue) Clear() {
-	q.mu.Lock()
-	defer q.mu.Unlock()
-
-	q.items = make(map[int]*QueueItem)
-	_ = q.save()
+	q.items = next
+	return nil
 }
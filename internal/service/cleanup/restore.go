@@ -0,0 +1,128 @@
+package cleanup
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	"github.com/fusionn-air/internal/client/radarr"
+	"github.com/fusionn-air/internal/client/sonarr"
+	"github.com/fusionn-air/pkg/logger"
+)
+
+var (
+	// ErrNotInLedger is returned when restoring an item that wasn't deleted by cleanup
+	ErrNotInLedger = errors.New("not found in deletion ledger")
+	// ErrAlreadyExists is returned when the item is already back in Sonarr/Radarr
+	ErrAlreadyExists = errors.New("already exists")
+)
+
+// GetLedger returns all recorded deletions
+func (s *Service) GetLedger() []*LedgerEntry {
+	return s.ledger.GetAll()
+}
+
+// SeasonDeleted reports whether cleanup deliberately deleted a season of a
+// series (by TVDB ID and Sonarr season number), so it isn't requested again
+func (s *Service) SeasonDeleted(tvdbID, season int) bool {
+	return s.ledger.SeasonDeleted(tvdbID, season)
+}
+
+// Restore re-adds a deleted series (by TVDB ID) or movie (by TMDB ID) to
+// Sonarr/Radarr with its recorded settings and triggers a search
+func (s *Service) Restore(ctx context.Context, t MediaType, externalID int) (*LedgerEntry, error) {
+	entry := s.ledger.Get(t, externalID)
+	if entry == nil {
+		return nil, ErrNotInLedger
+	}
+
+	switch t {
+	case MediaTypeSeries:
+		if err := s.restoreSeries(ctx, entry); err != nil {
+			return nil, err
+		}
+	case MediaTypeMovie:
+		if err := s.restoreMovie(ctx, entry); err != nil {
+			return nil, err
+		}
+	default:
+		return nil, fmt.Errorf("cannot restore media type %q", t)
+	}
+
+	s.ledger.MarkRestored(t, externalID)
+	logger.Infof("♻️  Restored %s: %s", t, entry.Title)
+	return s.ledger.Get(t, externalID), nil
+}
+
+func (s *Service) restoreSeries(ctx context.Context, entry *LedgerEntry) error {
+	if s.sonarr == nil {
+		return errors.New("sonarr is not configured")
+	}
+
+	existing, err := s.sonarr.GetSeriesByTvdbID(ctx, entry.ExternalID)
+	if err != nil {
+		return fmt.Errorf("checking sonarr: %w", err)
+	}
+	if existing != nil {
+		return fmt.Errorf("%s %w in Sonarr", entry.Title, ErrAlreadyExists)
+	}
+
+	// Soft-deleted files go back first so Sonarr picks them up
+	if err := s.restoreFromTrash(MediaTypeSeries, entry.ExternalID); err != nil {
+		return err
+	}
+
+	seasons := make([]sonarr.Season, 0, len(entry.Seasons))
+	for _, season := range entry.Seasons {
+		seasons = append(seasons, sonarr.Season{SeasonNumber: season.Number, Monitored: season.Monitored})
+	}
+
+	_, err = s.sonarr.AddSeries(ctx, sonarr.NewSeries{
+		Title:             entry.Title,
+		TvdbID:            entry.ExternalID,
+		QualityProfileID:  entry.QualityProfileID,
+		LanguageProfileID: entry.LanguageProfileID,
+		RootFolderPath:    entry.RootFolder,
+		Path:              entry.Path,
+		SeasonFolder:      entry.SeasonFolder,
+		SeriesType:        entry.SeriesType,
+		Monitored:         true,
+		Seasons:           seasons,
+		Tags:              entry.Tags,
+		AddOptions:        sonarr.AddSeriesOptions{SearchForMissingEpisodes: true},
+	})
+	return err
+}
+
+func (s *Service) restoreMovie(ctx context.Context, entry *LedgerEntry) error {
+	if s.radarr == nil {
+		return errors.New("radarr is not configured")
+	}
+
+	existing, err := s.radarr.GetMovieByTmdbID(ctx, entry.ExternalID)
+	if err != nil {
+		return fmt.Errorf("checking radarr: %w", err)
+	}
+	if existing != nil {
+		return fmt.Errorf("%s %w in Radarr", entry.Title, ErrAlreadyExists)
+	}
+
+	// Soft-deleted files go back first so Radarr picks them up
+	if err := s.restoreFromTrash(MediaTypeMovie, entry.ExternalID); err != nil {
+		return err
+	}
+
+	_, err = s.radarr.AddMovie(ctx, radarr.NewMovie{
+		Title:               entry.Title,
+		TmdbID:              entry.ExternalID,
+		Year:                entry.Year,
+		QualityProfileID:    entry.QualityProfileID,
+		RootFolderPath:      entry.RootFolder,
+		Path:                entry.Path,
+		MinimumAvailability: entry.Availability,
+		Monitored:           true,
+		Tags:                entry.Tags,
+		AddOptions:          radarr.AddMovieOptions{SearchForMovie: true},
+	})
+	return err
+}
@@ -0,0 +1,196 @@
+package cleanup
+
+import (
+	"context"
+	"fmt"
+	"sort"
+	"strings"
+	"time"
+
+	"github.com/fusionn-air/internal/client/sonarr"
+	"github.com/fusionn-air/internal/config"
+	"github.com/fusionn-air/pkg/logger"
+)
+
+// processDailyRetention keeps a rolling window of episodes for a daily show
+// and deletes the files of older, watched episodes. Daily shows never end, so
+// they are trimmed instead of being queued for whole-series deletion.
+func (s *Service) processDailyRetention(ctx context.Context, result *ProcessingResult, ser *sonarr.Series, labels arrLabels, viewers []*viewer, requireAll bool, queue *Queue, cfg *config.Config, dryRun bool) {
+	retention := cfg.Cleanup.DailyRetention
+
+	res := MediaResult{
+		Type:       MediaTypeSeries,
+		Title:      ser.Title,
+		ID:         ser.ID,
+		ExternalID: ser.TvdbID,
+		Action:     "skipped",
+	}
+
+	// Drop any whole-series queue entry from before retention was enabled
+	if err := s.dequeue(ctx, queue, ser.ID, "daily retention"); err != nil {
+		logger.Warnf("⚠️  Failed to dequeue %s: %v", res.Title, err)
+	}
+
+	// Same protections as whole-series cleanup
+	if entry := matchExclusion(s.exclusions(cfg), exclusionSubject{title: ser.Title, tvdbID: ser.TvdbID, imdbID: ser.ImdbID, tags: labels.tagLabels(ser.Tags)}); entry != "" {
+		res.Reason = exclusionReason(entry)
+		result.AddResult(res)
+		return
+	}
+	if reason := s.protectedReason(MediaTypeSeries, ser.TvdbID); reason != "" {
+		res.Reason = reason
+		result.AddResult(res)
+		return
+	}
+	rule := matchRule(cfg.Cleanup.Rules, seriesSubject(ser, labels))
+	if ruleAction(rule) != config.RuleActionDelete {
+		res.Reason = fmt.Sprintf("files kept (rule: %s)", ruleName(rule))
+		result.AddResult(res)
+		return
+	}
+	if held, reason := s.rewatchHeld(MediaTypeSeries, ser.TvdbID, viewers, ruleDelayDays(cfg, rule)); held {
+		res.Reason = reason
+		result.AddResult(res)
+		return
+	}
+
+	episodes, err := s.sonarr.GetEpisodes(ctx, ser.ID)
+	if err != nil {
+		logger.Errorf("❌ Failed to get episodes for %s: %v", ser.Title, err)
+		res.Action = "error"
+		res.Reason = fmt.Sprintf("sonarr error: %v", err)
+		result.AddResult(res)
+		return
+	}
+
+	candidates := retentionCandidates(episodes, retention, time.Now())
+	if len(candidates) == 0 {
+		res.Reason = fmt.Sprintf("daily show within retention (%s)", retentionWindow(retention))
+		result.AddResult(res)
+		return
+	}
+
+	watched, err := watchedEpisodes(ctx, viewers, requireAll, ser.TvdbID)
+	if err != nil {
+		logger.Errorf("❌ Failed to get watch progress for %s: %v", ser.Title, err)
+		res.Action = "error"
+		res.Reason = err.Error()
+		result.AddResult(res)
+		return
+	}
+
+	var episodeIDs []int
+	fileIDs := make(map[int]bool)
+	for _, ep := range candidates {
+		if watched[episodeKey{season: ep.SeasonNumber, episode: ep.EpisodeNumber}] {
+			episodeIDs = append(episodeIDs, ep.ID)
+			fileIDs[ep.EpisodeFileID] = true
+		}
+	}
+	if len(episodeIDs) == 0 {
+		res.Reason = fmt.Sprintf("older episodes not watched (%s)", retentionWindow(retention))
+		result.AddResult(res)
+		return
+	}
+
+	files, err := s.sonarr.GetEpisodeFiles(ctx, ser.ID)
+	if err != nil {
+		logger.Errorf("❌ Failed to get episode files for %s: %v", ser.Title, err)
+		res.Action = "error"
+		res.Reason = fmt.Sprintf("sonarr error: %v", err)
+		result.AddResult(res)
+		return
+	}
+
+	var deleteIDs []int
+	var size int64
+	for _, f := range files {
+		if fileIDs[f.ID] {
+			deleteIDs = append(deleteIDs, f.ID)
+			size += f.Size
+		}
+	}
+	if len(deleteIDs) == 0 {
+		res.Reason = fmt.Sprintf("daily show within retention (%s)", retentionWindow(retention))
+		result.AddResult(res)
+		return
+	}
+
+	res.Title = fmt.Sprintf("%s (%d episodes)", ser.Title, len(deleteIDs))
+	res.SizeOnDisk = sonarr.FormatSize(size)
+
+	if dryRun {
+		logger.Warnf("🗑️  [DRY RUN] Would delete old episode files: %s (%s)", res.Title, res.SizeOnDisk)
+		res.Action = "dry_run_remove"
+		res.Reason = fmt.Sprintf("would delete watched episodes outside retention (%s)", retentionWindow(retention))
+		result.AddResult(res)
+		return
+	}
+
+	// Unmonitor first so Sonarr doesn't search for the deleted episodes
+	if err := s.sonarr.UnmonitorEpisodes(ctx, episodeIDs); err != nil {
+		logger.Errorf("❌ Failed to unmonitor old episodes of %s: %v", ser.Title, err)
+		res.Action = "error"
+		res.Reason = fmt.Sprintf("unmonitor failed: %v", err)
+		result.AddResult(res)
+		return
+	}
+
+	if err := s.sonarr.DeleteEpisodeFiles(ctx, deleteIDs); err != nil {
+		logger.Errorf("❌ Failed to delete old episode files for %s: %v", ser.Title, err)
+		res.Action = "error"
+		res.Reason = fmt.Sprintf("delete failed: %v", err)
+		result.AddResult(res)
+		return
+	}
+
+	logger.Infof("✅ Deleted old episode files: %s (%s freed)", res.Title, res.SizeOnDisk)
+	res.Action = "removed"
+	res.Reason = fmt.Sprintf("watched episodes outside retention deleted (%s)", retentionWindow(retention))
+	result.AddResult(res)
+}
+
+// retentionCandidates returns episodes with files that fall outside both the
+// keep_episodes and keep_days windows. Specials are left alone.
+func retentionCandidates(episodes []sonarr.Episode, retention config.DailyRetentionConfig, now time.Time) []sonarr.Episode {
+	var onDisk []sonarr.Episode
+	for _, ep := range episodes {
+		if ep.HasFile && ep.EpisodeFileID > 0 && ep.SeasonNumber > 0 {
+			onDisk = append(onDisk, ep)
+		}
+	}
+
+	// Newest first
+	sort.Slice(onDisk, func(i, j int) bool {
+		return onDisk[i].AirDateUtc.After(onDisk[j].AirDateUtc)
+	})
+
+	cutoff := now.AddDate(0, 0, -retention.KeepDays)
+
+	var candidates []sonarr.Episode
+	for i, ep := range onDisk {
+		if i < retention.KeepEpisodes {
+			continue
+		}
+		if retention.KeepDays > 0 && ep.AirDateUtc.After(cutoff) {
+			continue
+		}
+		candidates = append(candidates, ep)
+	}
+	return candidates
+}
+
+// retentionWindow describes the retention settings for reasons
+func retentionWindow(retention config.DailyRetentionConfig) string {
+	var parts []string
+	if retention.KeepEpisodes > 0 {
+		parts = append(parts, fmt.Sprintf("last %d episodes", retention.KeepEpisodes))
+	}
+	if retention.KeepDays > 0 {
+		parts = append(parts, fmt.Sprintf("last %d days", retention.KeepDays))
+	}
+	if len(parts) == 0 {
+		return "no episodes kept"
+	}
+	return "keeping " + strings.Join(parts, " or ")
+}
@@ -0,0 +1,48 @@
+package cleanup
+
+import (
+	"reflect"
+	"testing"
+	"time"
+
+	"github.com/fusionn-air/internal/client/sonarr"
+	"github.com/fusionn-air/internal/config"
+)
+
+func TestRetentionCandidates(t *testing.T) {
+	now := time.Date(2026, 10, 16, 12, 0, 0, 0, time.UTC)
+	daysAgo := func(d int) time.Time { return now.AddDate(0, 0, -d) }
+
+	episodes := []sonarr.Episode{
+		{ID: 1, EpisodeFileID: 11, SeasonNumber: 2026, HasFile: true, AirDateUtc: daysAgo(1)},
+		{ID: 2, EpisodeFileID: 12, SeasonNumber: 2026, HasFile: true, AirDateUtc: daysAgo(3)},
+		{ID: 3, EpisodeFileID: 13, SeasonNumber: 2026, HasFile: true, AirDateUtc: daysAgo(10)},
+		{ID: 4, EpisodeFileID: 14, SeasonNumber: 2026, HasFile: true, AirDateUtc: daysAgo(30)},
+		{ID: 5, SeasonNumber: 2026, AirDateUtc: daysAgo(40)},                                    // No file
+		{ID: 6, EpisodeFileID: 16, SeasonNumber: 0, HasFile: true, AirDateUtc: daysAgo(50)},     // Special
+		{ID: 7, EpisodeFileID: 17, SeasonNumber: 2025, HasFile: true, AirDateUtc: daysAgo(300)}, // Oldest
+	}
+
+	tests := []struct {
+		name      string
+		retention config.DailyRetentionConfig
+		want      []int // Episode IDs, newest first
+	}{
+		{"keep episodes", config.DailyRetentionConfig{KeepEpisodes: 2}, []int{3, 4, 7}},
+		{"keep days", config.DailyRetentionConfig{KeepDays: 7}, []int{3, 4, 7}},
+		{"either limit keeps", config.DailyRetentionConfig{KeepEpisodes: 1, KeepDays: 14}, []int{4, 7}},
+		{"more kept than on disk", config.DailyRetentionConfig{KeepEpisodes: 10}, nil},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			var got []int
+			for _, ep := range retentionCandidates(episodes, tt.retention, now) {
+				got = append(got, ep.ID)
+			}
+			if !reflect.DeepEqual(got, tt.want) {
+				t.Errorf("retentionCandidates() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
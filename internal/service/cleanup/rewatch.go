@@ -0,0 +1,258 @@
+package cleanup
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"os"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/fusionn-air/internal/client/emby"
+	"github.com/fusionn-air/internal/config"
+	"github.com/fusionn-air/pkg/fileutil"
+	"github.com/fusionn-air/pkg/logger"
+)
+
+// Holds persists items taken off the queue because they're being rewatched
+// (rewatch action "cancel"), with the last activity seen
+type Holds struct {
+	mu       sync.RWMutex
+	items    map[string]time.Time // keyed by "<type>:<external id>"
+	filePath string
+}
+
+// NewHoldsWithFile creates a new hold list with a specific file path
+func NewHoldsWithFile(path string) *Holds {
+	h := &Holds{
+		items:    make(map[string]time.Time),
+		filePath: path,
+	}
+	_ = h.load()
+	return h
+}
+
+// Set holds an item, keeping the later activity time
+func (h *Holds) Set(mediaType MediaType, externalID int, activity time.Time) {
+	h.mu.Lock()
+	defer h.mu.Unlock()
+
+	key := holdKey(mediaType, externalID)
+	if activity.After(h.items[key]) {
+		h.items[key] = activity
+		_ = h.save()
+	}
+}
+
+// Get returns an item's last recorded activity, if held
+func (h *Holds) Get(mediaType MediaType, externalID int) (time.Time, bool) {
+	h.mu.RLock()
+	defer h.mu.RUnlock()
+
+	activity, held := h.items[holdKey(mediaType, externalID)]
+	return activity, held
+}
+
+// Release drops an item's hold
+func (h *Holds) Release(mediaType MediaType, externalID int) {
+	h.mu.Lock()
+	defer h.mu.Unlock()
+
+	delete(h.items, holdKey(mediaType, externalID))
+	_ = h.save()
+}
+
+func holdKey(mediaType MediaType, externalID int) string {
+	return fmt.Sprintf("%s:%d", mediaType, externalID)
+}
+
+// load reads the hold list from disk
+func (h *Holds) load() error {
+	data, err := os.ReadFile(h.filePath)
+	if err != nil {
+		return err
+	}
+	return json.Unmarshal(data, &h.items)
+}
+
+// save writes the hold list to disk
+func (h *Holds) save() error {
+	data, err := json.MarshalIndent(h.items, "", "  ")
+	if err != nil {
+		return err
+	}
+	return fileutil.WriteAtomic(h.filePath, data)
+}
+
+// nowPlaying is what's being played on Emby right now
+type nowPlaying struct {
+	tvdb map[int]string // Series TVDB ID → user
+	tmdb map[int]string // Movie TMDB ID → user
+}
+
+// embyNowPlaying maps active Emby playback sessions to TVDB/TMDB IDs
+func (s *Service) embyNowPlaying(ctx context.Context) (*nowPlaying, error) {
+	playing := &nowPlaying{tvdb: make(map[int]string), tmdb: make(map[int]string)}
+	if s.emby == nil {
+		return playing, nil
+	}
+
+	sessions, err := s.emby.GetSessions(ctx)
+	if err != nil {
+		return playing, err
+	}
+
+	seriesUsers := make(map[string]string) // Emby series ID → user
+	for _, session := range sessions {
+		item := session.NowPlayingItem
+		if item == nil {
+			continue
+		}
+		switch item.Type {
+		case "Movie":
+			if id := emby.ParseProviderID(item.ProviderIDs, "Tmdb"); id > 0 {
+				playing.tmdb[id] = session.UserName
+			}
+		case "Episode":
+			if item.SeriesID != "" {
+				seriesUsers[item.SeriesID] = session.UserName
+			}
+		}
+	}
+	if len(seriesUsers) == 0 {
+		return playing, nil
+	}
+
+	// Episodes only carry their own IDs; look up the series
+	ids := make([]string, 0, len(seriesUsers))
+	for id := range seriesUsers {
+		ids = append(ids, id)
+	}
+	series, err := s.emby.GetItemsByID(ctx, ids)
+	if err != nil {
+		return playing, err
+	}
+	for _, ser := range series {
+		if id := emby.ParseProviderID(ser.ProviderIDs, "Tvdb"); id > 0 {
+			playing.tvdb[id] = seriesUsers[ser.ID]
+		}
+	}
+	return playing, nil
+}
+
+// watchActivity returns the latest watch time and total plays across viewers
+func watchActivity(viewers []*viewer, mediaType MediaType, externalID int) (time.Time, int) {
+	var last time.Time
+	plays := 0
+	for _, v := range viewers {
+		if mediaType.isSeries() {
+			if show := v.shows[externalID]; show != nil {
+				plays += show.Plays
+				if show.LastWatchedAt.After(last) {
+					last = show.LastWatchedAt
+				}
+			}
+		} else if movie := v.movies[externalID]; movie != nil {
+			plays += movie.Plays
+			if movie.LastWatchedAt.After(last) {
+				last = movie.LastWatchedAt
+			}
+		}
+	}
+	return last, plays
+}
+
+// checkRewatches looks for watch activity on queued items since they were
+// queued and restarts their delay or takes them off the queue
+func (s *Service) checkRewatches(ctx context.Context, queue *Queue, viewers []*viewer, cfg *config.Config, dryRun bool) {
+	rewatch := cfg.Cleanup.Rewatch
+	if !rewatch.Enabled {
+		return
+	}
+
+	items := queue.GetAll()
+	if len(items) == 0 {
+		return
+	}
+
+	playing, err := s.embyNowPlaying(ctx)
+	if err != nil {
+		logger.Warnf("⚠️  Failed to get Emby sessions: %v", err)
+	}
+
+	for _, item := range items {
+		last, plays := watchActivity(viewers, item.Type, item.ExternalID)
+
+		var who string
+		if item.Type.isSeries() {
+			who = playing.tvdb[item.ExternalID]
+		} else {
+			who = playing.tmdb[item.ExternalID]
+		}
+
+		var activity string
+		switch {
+		case who != "":
+			last = time.Now()
+			activity = fmt.Sprintf("playing on Emby (%s)", who)
+		case item.Plays > 0 && plays > item.Plays:
+			activity = fmt.Sprintf("%d new play(s)", plays-item.Plays)
+		case last.After(item.MarkedAt):
+			activity = fmt.Sprintf("watched %s", last.Format("2006-01-02"))
+		}
+
+		if activity == "" {
+			// Record the play count to compare against next run
+			if plays > item.Plays {
+				if err := queue.Update(item.ID, func(i *QueueItem) { i.Plays = plays }); err != nil {
+					logger.Warnf("⚠️  Failed to save plays for %s: %v", item.Title, err)
+				}
+			}
+			continue
+		}
+
+		if strings.EqualFold(rewatch.Action, config.RewatchActionCancel) {
+			if dryRun {
+				logger.Warnf("🔁 [DRY RUN] Would take %s off the queue - rewatching (%s)", item.Title, activity)
+				continue
+			}
+			s.holds.Set(item.Type, item.ExternalID, last)
+			if err := s.dequeue(ctx, queue, item.ID, "rewatching: "+activity); err != nil {
+				logger.Warnf("⚠️  Failed to dequeue %s: %v", item.Title, err)
+			}
+			continue
+		}
+
+		if dryRun {
+			logger.Warnf("🔁 [DRY RUN] Would restart delay for %s - rewatching (%s)", item.Title, activity)
+			continue
+		}
+		if err := queue.Update(item.ID, func(i *QueueItem) {
+			i.MarkedAt = time.Now()
+			i.Plays = plays
+		}); err != nil {
+			logger.Warnf("⚠️  Failed to reset %s in queue: %v", item.Title, err)
+			continue
+		}
+		logger.Infof("🔁 Restarted delay for %s - rewatching (%s)", item.Title, activity)
+	}
+}
+
+// rewatchHeld reports whether an item taken off the queue for a rewatch
+// should stay off: until nobody has watched it for the item's delay
+func (s *Service) rewatchHeld(mediaType MediaType, externalID int, viewers []*viewer, delayDays int) (bool, string) {
+	held, ok := s.holds.Get(mediaType, externalID)
+	if !ok {
+		return false, ""
+	}
+
+	if last, _ := watchActivity(viewers, mediaType, externalID); last.After(held) {
+		held = last
+	}
+	if time.Since(held) >= time.Duration(delayDays)*24*time.Hour {
+		s.holds.Release(mediaType, externalID)
+		return false, ""
+	}
+	return true, fmt.Sprintf("rewatching (last activity %s)", held.Format("2006-01-02"))
+}
@@ -0,0 +1,262 @@
+package cleanup
+
+import (
+	"context"
+	"strings"
+
+	"github.com/fusionn-air/internal/client/emby"
+	"github.com/fusionn-air/internal/client/jellyfin"
+	"github.com/fusionn-air/internal/client/plex"
+	"github.com/fusionn-air/internal/client/radarr"
+	"github.com/fusionn-air/internal/client/sonarr"
+	"github.com/fusionn-air/internal/config"
+	"github.com/fusionn-air/pkg/logger"
+)
+
+// ruleSubject is what cleanup rules match against
+type ruleSubject struct {
+	tags           []string
+	qualityProfile string
+	path           string
+	genres         []string
+	network        string
+	library        string
+	year           int
+}
+
+// arrLabels resolves Sonarr/Radarr tag and quality profile IDs to names
+type arrLabels struct {
+	tags     map[int]string
+	profiles map[int]string
+}
+
+// sonarrLabels fetches Sonarr tag and profile names when rules or tag exclusions need them
+func (s *Service) sonarrLabels(ctx context.Context, cfg *config.Config) arrLabels {
+	labels := arrLabels{tags: make(map[int]string), profiles: make(map[int]string)}
+	if len(cfg.Cleanup.Rules) == 0 && !hasTagExclusions(cfg.Cleanup.Exclusions) {
+		return labels
+	}
+
+	tags, err := s.sonarr.GetTags(ctx)
+	if err != nil {
+		logger.Warnf("⚠️  Failed to get Sonarr tags: %v — tag rules won't match", err)
+	}
+	for _, t := range tags {
+		labels.tags[t.ID] = t.Label
+	}
+
+	profiles, err := s.sonarr.GetQualityProfiles(ctx)
+	if err != nil {
+		logger.Warnf("⚠️  Failed to get Sonarr quality profiles: %v — profile rules won't match", err)
+	}
+	for _, p := range profiles {
+		labels.profiles[p.ID] = p.Name
+	}
+
+	return labels
+}
+
+// radarrLabels fetches Radarr tag and profile names when rules or tag exclusions need them
+func (s *Service) radarrLabels(ctx context.Context, cfg *config.Config) arrLabels {
+	labels := arrLabels{tags: make(map[int]string), profiles: make(map[int]string)}
+	if len(cfg.Cleanup.Rules) == 0 && !hasTagExclusions(cfg.Cleanup.Exclusions) {
+		return labels
+	}
+
+	tags, err := s.radarr.GetTags(ctx)
+	if err != nil {
+		logger.Warnf("⚠️  Failed to get Radarr tags: %v — tag rules won't match", err)
+	}
+	for _, t := range tags {
+		labels.tags[t.ID] = t.Label
+	}
+
+	profiles, err := s.radarr.GetQualityProfiles(ctx)
+	if err != nil {
+		logger.Warnf("⚠️  Failed to get Radarr quality profiles: %v — profile rules won't match", err)
+	}
+	for _, p := range profiles {
+		labels.profiles[p.ID] = p.Name
+	}
+
+	return labels
+}
+
+func (l arrLabels) tagLabels(ids []int) []string {
+	var names []string
+	for _, id := range ids {
+		if name, ok := l.tags[id]; ok {
+			names = append(names, name)
+		}
+	}
+	return names
+}
+
+func seriesSubject(ser *sonarr.Series, labels arrLabels) ruleSubject {
+	return ruleSubject{
+		tags:           labels.tagLabels(ser.Tags),
+		qualityProfile: labels.profiles[ser.QualityProfileID],
+		path:           ser.Path,
+		genres:         ser.Genres,
+		network:        ser.Network,
+		year:           ser.Year,
+	}
+}
+
+func movieSubject(movie *radarr.Movie, labels arrLabels) ruleSubject {
+	return ruleSubject{
+		tags:           labels.tagLabels(movie.Tags),
+		qualityProfile: labels.profiles[movie.QualityProfileID],
+		path:           movie.Path,
+		genres:         movie.Genres,
+		year:           movie.Year,
+	}
+}
+
+func embySubject(item emby.Item, library string) ruleSubject {
+	subject := ruleSubject{
+		path:    item.Path,
+		genres:  item.Genres,
+		library: library,
+		year:    item.ProductionYear,
+	}
+	if len(item.Studios) > 0 {
+		subject.network = item.Studios[0].Name
+	}
+	return subject
+}
+
+func jellyfinSubject(item jellyfin.Item, library string) ruleSubject {
+	subject := ruleSubject{
+		path:    item.Path,
+		genres:  item.Genres,
+		library: library,
+		year:    item.ProductionYear,
+	}
+	if len(item.Studios) > 0 {
+		subject.network = item.Studios[0].Name
+	}
+	return subject
+}
+
+// plexSubject has no path for shows; section listings only carry movie files
+func plexSubject(item plex.Metadata, library string) ruleSubject {
+	subject := ruleSubject{
+		path:    item.File(),
+		network: item.Studio,
+		library: library,
+		year:    item.Year,
+	}
+	for _, genre := range item.Genres {
+		subject.genres = append(subject.genres, genre.Tag)
+	}
+	return subject
+}
+
+// matchRule returns the first rule matching subject, or nil
+func matchRule(rules []config.CleanupRule, subject ruleSubject) *config.CleanupRule {
+	for i := range rules {
+		if ruleMatches(&rules[i], subject) {
+			return &rules[i]
+		}
+	}
+	return nil
+}
+
+func ruleMatches(rule *config.CleanupRule, subject ruleSubject) bool {
+	if len(rule.Tags) > 0 && !anyEqualFold(rule.Tags, subject.tags...) {
+		return false
+	}
+	if len(rule.QualityProfiles) > 0 && !anyEqualFold(rule.QualityProfiles, subject.qualityProfile) {
+		return false
+	}
+	if len(rule.Genres) > 0 && !anyEqualFold(rule.Genres, subject.genres...) {
+		return false
+	}
+	if len(rule.Networks) > 0 && !anyEqualFold(rule.Networks, subject.network) {
+		return false
+	}
+	if len(rule.Libraries) > 0 && !anyEqualFold(rule.Libraries, subject.library) {
+		return false
+	}
+	if len(rule.RootPaths) > 0 {
+		found := false
+		for _, root := range rule.RootPaths {
+			if root != "" && strings.HasPrefix(subject.path, root) {
+				found = true
+				break
+			}
+		}
+		if !found {
+			return false
+		}
+	}
+	if rule.YearMin > 0 && (subject.year == 0 || subject.year < rule.YearMin) {
+		return false
+	}
+	if rule.YearMax > 0 && (subject.year == 0 || subject.year > rule.YearMax) {
+		return false
+	}
+	return true
+}
+
+// anyEqualFold checks if any value is in list (case-insensitive)
+func anyEqualFold(list []string, values ...string) bool {
+	for _, v := range values {
+		for _, l := range list {
+			if v != "" && strings.EqualFold(l, v) {
+				return true
+			}
+		}
+	}
+	return false
+}
+
+// ruleName identifies a rule in queue items and reasons
+func ruleName(rule *config.CleanupRule) string {
+	if rule == nil {
+		return ""
+	}
+	return rule.Name
+}
+
+// ruleByName finds the current rule a queue item was queued under
+func ruleByName(rules []config.CleanupRule, name string) *config.CleanupRule {
+	if name == "" {
+		return nil
+	}
+	for i := range rules {
+		if strings.EqualFold(rules[i].Name, name) {
+			return &rules[i]
+		}
+	}
+	return nil
+}
+
+// ruleAction returns a rule's action, defaulting to delete
+func ruleAction(rule *config.CleanupRule) string {
+	if rule == nil || rule.Action == "" {
+		return config.RuleActionDelete
+	}
+	return strings.ToLower(rule.Action)
+}
+
+// ruleDelayDays returns a rule's delay, defaulting to cleanup.delay_days
+func ruleDelayDays(cfg *config.Config, rule *config.CleanupRule) int {
+	if rule != nil && rule.DelayDays != nil {
+		return *rule.DelayDays
+	}
+	return cfg.Cleanup.DelayDays
+}
+
+// itemDelay returns the delay for a queued item under its rule
+func itemDelay(cfg *config.Config, item *QueueItem) int {
+	return ruleDelayDays(cfg, ruleByName(cfg.Cleanup.Rules, item.Rule))
+}
+
+// ruleDelay returns the per-item delay function used by the queues
+func ruleDelay(cfg *config.Config) func(*QueueItem) int {
+	return func(item *QueueItem) int {
+		return itemDelay(cfg, item)
+	}
+}
@@ -0,0 +1,98 @@
+package cleanup
+
+import (
+	"reflect"
+	"testing"
+
+	"github.com/fusionn-air/internal/client/jellyfin"
+	"github.com/fusionn-air/internal/client/plex"
+	"github.com/fusionn-air/internal/config"
+)
+
+func TestRuleAction(t *testing.T) {
+	tests := []struct {
+		name string
+		rule *config.CleanupRule
+		want string
+	}{
+		{"no rule", nil, config.RuleActionDelete},
+		{"empty action", &config.CleanupRule{Name: "r"}, config.RuleActionDelete},
+		{"delete", &config.CleanupRule{Action: "delete"}, config.RuleActionDelete},
+		{"unmonitor mixed case", &config.CleanupRule{Action: "Unmonitor"}, config.RuleActionUnmonitor},
+		{"never upper case", &config.CleanupRule{Action: "NEVER"}, config.RuleActionNever},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := ruleAction(tt.rule); got != tt.want {
+				t.Errorf("ruleAction() = %q, want %q", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestMatchRule(t *testing.T) {
+	rules := []config.CleanupRule{
+		{Name: "kids", Libraries: []string{"Kids"}, Action: "never"},
+		{Name: "anime", Genres: []string{"anime"}, Tags: []string{"keep"}},
+		{Name: "hbo", Networks: []string{"HBO"}, YearMax: 2010},
+		{Name: "archive", RootPaths: []string{"/media/archive/"}},
+		{Name: "recent", YearMin: 2020},
+	}
+
+	tests := []struct {
+		name    string
+		subject ruleSubject
+		want    string // Matched rule name, "" for none
+	}{
+		{"no conditions met", ruleSubject{year: 2015}, ""},
+		{"library case-insensitive", ruleSubject{library: "kids", year: 2022}, "kids"},
+		{"first match wins", ruleSubject{library: "Kids", genres: []string{"Anime"}, tags: []string{"keep"}}, "kids"},
+		{"all conditions must match", ruleSubject{genres: []string{"Anime"}}, ""},
+		{"any list entry matches", ruleSubject{genres: []string{"Drama", "Anime"}, tags: []string{"4k", "KEEP"}}, "anime"},
+		{"year upper bound", ruleSubject{network: "hbo", year: 2008}, "hbo"},
+		{"year upper bound exceeded", ruleSubject{network: "HBO", year: 2012}, ""},
+		{"unknown year never matches bounds", ruleSubject{network: "HBO"}, ""},
+		{"root path prefix", ruleSubject{path: "/media/archive/Show"}, "archive"},
+		{"root path is a prefix only", ruleSubject{path: "/other/media/archive/Show"}, ""},
+		{"year lower bound", ruleSubject{year: 2021}, "recent"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got := ruleName(matchRule(rules, tt.subject))
+			if got != tt.want {
+				t.Errorf("matchRule() = %q, want %q", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestMediaServerSubjects(t *testing.T) {
+	jf := jellyfin.Item{
+		Path:           "/tv/Show",
+		Genres:         []string{"Drama"},
+		Studios:        []jellyfin.Studio{{Name: "AMC"}, {Name: "Other"}},
+		ProductionYear: 2008,
+	}
+	if got, want := jellyfinSubject(jf, "TV"), (ruleSubject{path: "/tv/Show", genres: []string{"Drama"}, network: "AMC", library: "TV", year: 2008}); !reflect.DeepEqual(got, want) {
+		t.Errorf("jellyfinSubject() = %+v, want %+v", got, want)
+	}
+
+	px := plex.Metadata{
+		Year:   1999,
+		Studio: "Warner Bros.",
+		Genres: []plex.Tag{{Tag: "Action"}, {Tag: "Science Fiction"}},
+		Media:  []plex.Media{{ID: 1, Part: []plex.Part{{File: "/movies/The Matrix (1999)/The Matrix.mkv"}}}},
+	}
+	want := ruleSubject{
+		path:    "/movies/The Matrix (1999)/The Matrix.mkv",
+		genres:  []string{"Action", "Science Fiction"},
+		network: "Warner Bros.",
+		library: "Movies",
+		year:    1999,
+	}
+	if got := plexSubject(px, "Movies"); !reflect.DeepEqual(got, want) {
+		t.Errorf("plexSubject() = %+v, want %+v", got, want)
+	}
+}
@@ -0,0 +1,116 @@
+package cleanup
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/fusionn-air/internal/client/sonarr"
+	"github.com/fusionn-air/internal/config"
+	"github.com/fusionn-air/pkg/logger"
+)
+
+// processSeasonCleanup deletes the episode files of old, fully watched seasons
+// of a series that is staying in Sonarr, and unmonitors those seasons.
+func (s *Service) processSeasonCleanup(ctx context.Context, result *ProcessingResult, ser *sonarr.Series, labels arrLabels, viewers []*viewer, requireAll bool, cfg *config.Config, dryRun bool) {
+	// Same protections as whole-series cleanup
+	if matchExclusion(s.exclusions(cfg), exclusionSubject{title: ser.Title, tvdbID: ser.TvdbID, imdbID: ser.ImdbID, tags: labels.tagLabels(ser.Tags)}) != "" {
+		return
+	}
+	if s.protectedReason(MediaTypeSeries, ser.TvdbID) != "" {
+		return
+	}
+	rule := matchRule(cfg.Cleanup.Rules, seriesSubject(ser, labels))
+	if ruleAction(rule) != config.RuleActionDelete {
+		return
+	}
+	if held, _ := s.rewatchHeld(MediaTypeSeries, ser.TvdbID, viewers, ruleDelayDays(cfg, rule)); held {
+		return
+	}
+
+	// Candidates: seasons with files that finished airing at least min_age_days ago
+	cutoff := time.Now().AddDate(0, 0, -cfg.Cleanup.Seasons.MinAgeDays)
+	candidates := make(map[int]int)
+	for _, season := range ser.Seasons {
+		stats := season.Statistics
+		if season.SeasonNumber == 0 || stats == nil || stats.EpisodeFileCount == 0 {
+			continue
+		}
+		if stats.NextAiring != nil || stats.PreviousAiring == nil || stats.PreviousAiring.After(cutoff) {
+			continue
+		}
+		candidates[season.SeasonNumber] = stats.EpisodeFileCount
+	}
+	if len(candidates) == 0 {
+		return
+	}
+
+	watched, err := watchedSeasons(ctx, viewers, requireAll, ser.TvdbID, candidates)
+	if err != nil {
+		logger.Warnf("⚠️  Season cleanup skipped for %s: %v", ser.Title, err)
+		return
+	}
+	if len(watched) == 0 {
+		return
+	}
+
+	files, err := s.sonarr.GetEpisodeFiles(ctx, ser.ID)
+	if err != nil {
+		logger.Errorf("❌ Failed to get episode files for %s: %v", ser.Title, err)
+		return
+	}
+
+	for _, seasonNum := range watched {
+		var fileIDs []int
+		var size int64
+		for _, f := range files {
+			if f.SeasonNumber == seasonNum {
+				fileIDs = append(fileIDs, f.ID)
+				size += f.Size
+			}
+		}
+		if len(fileIDs) == 0 {
+			continue
+		}
+
+		res := MediaResult{
+			Type:       MediaTypeSeries,
+			Title:      fmt.Sprintf("%s S%02d", ser.Title, seasonNum),
+			ID:         ser.ID,
+			ExternalID: ser.TvdbID,
+			SizeOnDisk: sonarr.FormatSize(size),
+		}
+
+		if dryRun {
+			logger.Warnf("🗑️  [DRY RUN] Would delete season files: %s (%s)", res.Title, res.SizeOnDisk)
+			res.Action = "dry_run_remove"
+			res.Reason = "would delete season files (fully watched)"
+			result.AddResult(res)
+			continue
+		}
+
+		// Unmonitor first so Sonarr doesn't search for the deleted episodes
+		if err := s.sonarr.UnmonitorSeason(ctx, ser.ID, seasonNum); err != nil {
+			logger.Errorf("❌ Failed to unmonitor %s: %v", res.Title, err)
+			res.Action = "error"
+			res.Reason = fmt.Sprintf("unmonitor failed: %v", err)
+			result.AddResult(res)
+			continue
+		}
+
+		if err := s.sonarr.DeleteEpisodeFiles(ctx, fileIDs); err != nil {
+			logger.Errorf("❌ Failed to delete season files for %s: %v", res.Title, err)
+			res.Action = "error"
+			res.Reason = fmt.Sprintf("delete failed: %v", err)
+			result.AddResult(res)
+			continue
+		}
+
+		logger.Infof("✅ Deleted season files: %s (%s freed)", res.Title, res.SizeOnDisk)
+		res.Action = "removed"
+		res.Reason = "season files deleted (fully watched)"
+		// Recorded so the watcher's backfill doesn't request it again
+		s.ledger.AddSeason(ser, seasonNum, size, res.Reason)
+		result.AddResult(res)
+	}
+}
@@ -37,28 +37,36 @@ func (s *Service) processSeries(ctx context.Context, result *ProcessingResult, c
 	result.IncrementScanned(MediaTypeSeries, len(series))
 	logger.Infof("📺 Found %d series in Sonarr", len(series))
 
-	// Get watched shows from Trakt
-	logger.Info("👁️  Fetching TV watch history from Trakt...")
-	watchedShows, err := s.trakt.GetWatchedShows(ctx)
-	if err != nil {
+	// Get watched shows for everyone the watch policy evaluates
+	viewers, requireAll := s.viewers(cfg)
+	logger.Infof("👁️  Fetching TV watch history from %s...", sourceName(viewers))
+	if err := loadWatchedShows(ctx, viewers); err != nil {
 		logger.Errorf("❌ Failed to get watched shows: %v", err)
 		return
 	}
 
-	// Build lookup by TVDB ID
-	watchedByTvdb := make(map[int]*trakt.WatchedShow)
-	for i := range watchedShows {
-		if watchedShows[i].Show.IDs.TVDB > 0 {
-			watchedByTvdb[watchedShows[i].Show.IDs.TVDB] = &watchedShows[i]
-		}
-	}
+	s.checkRewatches(ctx, queue, viewers, cfg, dryRun)
+
+	labels := s.sonarrLabels(ctx, cfg)
 
 	// Process each series
 	for _, ser := range series {
-		res := s.processOneSeries(ctx, &ser, watchedByTvdb, queue, cfg)
-		// Unmonitor if newly queued
-		if res.Action == "queued" && res.Reason != "" && strings.HasSuffix(res.Reason, "added to queue") {
-			s.unmonitorSeries(ctx, ser.ID, ser.Title, queue, dryRun)
+		// Daily shows keep a rolling window of episodes instead
+		if cfg.Cleanup.DailyRetention.Enabled && ser.SeriesType == sonarr.SeriesTypeDaily {
+			s.processDailyRetention(ctx, result, &ser, labels, viewers, requireAll, queue, cfg, dryRun)
+			continue
+		}
+		res, shedSeasons := s.processOneSeries(ctx, &ser, labels, viewers, requireAll, queue, cfg)
+		// Series kept because they're ongoing or not fully watched can still
+		// shed old, fully watched seasons
+		if cfg.Cleanup.Seasons.Enabled && shedSeasons {
+			s.processSeasonCleanup(ctx, result, &ser, labels, viewers, requireAll, cfg, dryRun)
+		}
+		// Unmonitor queued series that haven't been yet
+		if res.Action == "queued" {
+			if item := queue.Get(ser.ID); item != nil && item.UnmonitoredAt == nil {
+				s.unmonitorSeries(ctx, ser.ID, ser.Title, queue, dryRun)
+			}
 		}
 		// Only add non-empty results (skip items ready for removal)
 		if res.ID != 0 {
@@ -70,49 +78,83 @@ func (s *Service) processSeries(ctx context.Context, result *ProcessingResult, c
 	return
 }
 
-func (s *Service) processOneSeries(ctx context.Context, ser *sonarr.Series, watchedByTvdb map[int]*trakt.WatchedShow, queue *Queue, cfg *config.Config) MediaResult {
-	res := MediaResult{
+func (s *Service) processOneSeries(ctx context.Context, ser *sonarr.Series, labels arrLabels, viewers []*viewer, requireAll bool, queue *Queue, cfg *config.Config) (res MediaResult, shedSeasons bool) {
+	res = MediaResult{
 		Type:       MediaTypeSeries,
 		Title:      ser.Title,
 		ID:         ser.ID,
+		ExternalID: ser.TvdbID,
 		SizeOnDisk: sonarr.FormatSize(ser.Statistics.SizeOnDisk),
 	}
 
 	// Check exclusions
-	if isExcluded(ser.Title, cfg.Cleanup.Exclusions) {
+	if exc := matchExclusion(s.exclusions(cfg), exclusionSubject{title: ser.Title, tvdbID: ser.TvdbID, imdbID: ser.ImdbID, tags: labels.tagLabels(ser.Tags)}); exc != "" {
+		if err := s.dequeue(ctx, queue, ser.ID, "excluded"); err != nil {
+			logger.Warnf("⚠️  Failed to dequeue %s: %v", res.Title, err)
+		}
+		res.Action = "skipped"
+		res.Reason = exclusionReason(exc)
+		return res, false
+	}
+
+	if reason := s.protectedReason(res.Type, res.ExternalID); reason != "" {
+		if err := s.dequeue(ctx, queue, res.ID, reason); err != nil {
+			logger.Warnf("⚠️  Failed to dequeue %s: %v", res.Title, err)
+		}
 		res.Action = "skipped"
-		res.Reason = "in exclusion list"
-		return res
+		res.Reason = reason
+		return res, false
+	}
+
+	// Apply the first matching cleanup rule
+	rule := matchRule(cfg.Cleanup.Rules, seriesSubject(ser, labels))
+	if ruleAction(rule) == config.RuleActionNever {
+		if err := s.dequeue(ctx, queue, ser.ID, "never-delete rule"); err != nil {
+			logger.Warnf("⚠️  Failed to dequeue %s: %v", res.Title, err)
+		}
+		res.Action = "skipped"
+		res.Reason = fmt.Sprintf("never deleted (rule: %s)", ruleName(rule))
+		return res, false
 	}
 
 	// Check if already in queue (before checking monitored status)
 	// This ensures queued items remain visible even after being unmonitored
 	// However, skip items that are ready for removal - they'll appear in REMOVED section
 	if queue.IsQueued(ser.ID) {
+		// A newly announced season puts the series back in rotation
+		if ongoing, reason := s.queuedSeriesOngoing(ctx, ser, viewers, requireAll); ongoing {
+			if err := s.dequeue(ctx, queue, ser.ID, reason); err != nil {
+				logger.Warnf("⚠️  Failed to dequeue %s: %v", res.Title, err)
+			}
+			res.Action = "skipped"
+			res.Reason = reason
+			return res, true
+		}
+
 		// If item is ready for removal, skip it here so it doesn't appear twice
 		// It will be processed by processSeriesRemovalQueue and appear as "removed"
-		if queue.IsReadyForRemoval(ser.ID, cfg.Cleanup.DelayDays) {
+		if queue.IsReadyForRemoval(ser.ID, ruleDelay(cfg)) {
 			// Return empty result - this item will be handled by removal queue
-			return MediaResult{}
+			return MediaResult{}, false
 		}
 
 		queueItem := queue.Get(ser.ID)
 		daysInQueue := int(time.Since(queueItem.MarkedAt).Hours() / 24)
-		daysUntil := cfg.Cleanup.DelayDays - daysInQueue
+		daysUntil := itemDelay(cfg, queueItem) - daysInQueue
 		if daysUntil < 0 {
 			daysUntil = 0
 		}
 		res.Action = "queued"
 		res.Reason = queueItem.Reason + " - queued for deletion (unmonitored)"
 		res.DaysUntil = daysUntil
-		return res
+		return res, false
 	}
 
 	// Check if series is monitored
 	if !ser.Monitored {
 		res.Action = "skipped"
 		res.Reason = "not monitored"
-		return res
+		return res, false
 	}
 
 	// Check if series has any files
@@ -123,77 +165,94 @@ func (s *Service) processOneSeries(ctx context.Context, ser *sonarr.Series, watc
 		} else {
 			res.Reason = "no files on disk"
 		}
-		return res
-	}
-
-	// Find in Trakt watched shows
-	watched, found := watchedByTvdb[ser.TvdbID]
-	if !found {
-		res.Action = "skipped"
-		res.Reason = "no watch history"
-		return res
+		return res, false
 	}
 
-	// Get detailed progress from Trakt
-	progress, err := s.trakt.GetShowProgress(ctx, watched.Show.IDs.Trakt)
+	// Check if viewers have watched all episodes that are ON DISK
+	verdict, err := evaluateSeries(ctx, viewers, requireAll, ser.TvdbID, func() (map[int]int, error) {
+		return sonarrSeasonFiles(ser), nil
+	})
 	if err != nil {
 		res.Action = "error"
-		res.Reason = fmt.Sprintf("trakt error: %v", err)
-		return res
+		res.Reason = err.Error()
+		return res, false
 	}
-
-	// Get season info for total episode counts
-	seasons, _ := s.trakt.GetShowSeasons(ctx, watched.Show.IDs.Trakt)
-
-	// Check if user has watched all episodes that are ON DISK
-	watchedOnDisk, unwatchedSeasons := checkWatchedOnDisk(ser, progress)
-	if !watchedOnDisk {
+	if !verdict.watched {
 		res.Action = "skipped"
-		res.Reason = buildWatchingReason(progress, seasons, unwatchedSeasons)
-		return res
+		res.Reason = verdict.reason
+		return res, true
 	}
 
 	// Check if more episodes are coming
-	moreEpisodesComing, ongoingReason := checkMoreEpisodesComing(ser, progress, seasons)
+	moreEpisodesComing, ongoingReason := checkMoreEpisodesComing(ser, verdict.progress, verdict.seasons)
 	if moreEpisodesComing {
-		if queue.IsQueued(ser.ID) {
-			queue.Remove(ser.ID)
-			logger.Debugf("Removed %s from queue - more episodes coming", ser.Title)
-		}
 		res.Action = "skipped"
 		res.Reason = ongoingReason
-		return res
+		return res, true
 	}
 
 	// All episodes on disk are watched and no more coming
 	seasonsOnDisk := getSeasonsWithFiles(ser)
-	watchedReason := fmt.Sprintf("fully watched (S%s)", formatSeasons(seasonsOnDisk))
+	watchedReason := fmt.Sprintf("fully watched (S%s)%s", formatSeasons(seasonsOnDisk), watchedBySuffix(viewers, verdict.watchedBy))
+
+	if rule != nil {
+		watchedReason += fmt.Sprintf(" [rule: %s]", ruleName(rule))
+	}
+
+	// Someone is rewatching it; keep it off the queue for now
+	if held, reason := s.rewatchHeld(MediaTypeSeries, ser.TvdbID, viewers, ruleDelayDays(cfg, rule)); held {
+		res.Action = "skipped"
+		res.Reason = reason
+		return res, false
+	}
 
 	// Add to queue
-	queue.Add(&QueueItem{
-		ID:         ser.ID,
-		ExternalID: ser.TvdbID,
-		Title:      ser.Title,
-		MarkedAt:   time.Now(),
-		Reason:     watchedReason,
-		SizeOnDisk: ser.Statistics.SizeOnDisk,
-	})
+	if err := queue.Add(&QueueItem{
+		ID:           ser.ID,
+		ExternalID:   ser.TvdbID,
+		Title:        ser.Title,
+		MarkedAt:     time.Now(),
+		Reason:       watchedReason,
+		SizeOnDisk:   ser.Statistics.SizeOnDisk,
+		Path:         ser.Path,
+		Rule:         ruleName(rule),
+		MonitorState: seriesMonitorState(ser),
+	}); err != nil {
+		logger.Errorf("❌ Failed to queue %s: %v", res.Title, err)
+		res.Action = "error"
+		res.Reason = fmt.Sprintf("queue save failed: %v", err)
+		return res, false
+	}
 
 	res.Action = "queued"
 	res.Reason = watchedReason + " - queued for deletion (unmonitored)"
-	res.DaysUntil = cfg.Cleanup.DelayDays
-	return res
+	res.DaysUntil = ruleDelayDays(cfg, rule)
+	return res, false
 }
 
 func (s *Service) processSeriesRemovalQueue(ctx context.Context, result *ProcessingResult, queue *Queue, cfg *config.Config, dryRun bool) {
-	ready := queue.GetReadyForRemoval(cfg.Cleanup.DelayDays)
-	if len(ready) == 0 {
+	ready := queue.GetReadyForRemoval(ruleDelay(cfg))
+	early := s.diskPressureRemovals(ctx, "Sonarr", s.sonarrRootFolders, queue, ready, cfg)
+	if len(ready) == 0 && len(early) == 0 {
 		return
 	}
 
-	logger.Infof("🗑️  %d series ready for removal", len(ready))
+	if len(ready) > 0 {
+		logger.Infof("🗑️  %d series ready for removal", len(ready))
+	}
+
+	earlyIDs := make(map[int]bool, len(early))
+	for _, item := range early {
+		earlyIDs[item.ID] = true
+	}
+
+	s.removeSeriesItems(ctx, result, queue, append(ready, early...), earlyIDs, cfg, dryRun)
+}
 
-	for _, item := range ready {
+// removeSeriesItems deletes queued items and drops them from the queue.
+// Items in earlyIDs are removed ahead of their delay (disk pressure).
+func (s *Service) removeSeriesItems(ctx context.Context, result *ProcessingResult, queue *Queue, items []*QueueItem, earlyIDs map[int]bool, cfg *config.Config, dryRun bool) {
+	for _, item := range items {
 		ser, err := s.sonarr.GetSeries(ctx, item.ID)
 		if err != nil {
 			logger.Errorf("❌ Error checking series %s: %v", item.Title, err)
@@ -202,7 +261,26 @@ func (s *Service) processSeriesRemovalQueue(ctx context.Context, result *Process
 
 		if ser == nil {
 			logger.Infof("ℹ️  %s already removed, clearing from queue", item.Title)
-			queue.Remove(item.ID)
+			if err := queue.Remove(item.ID); err != nil {
+				logger.Warnf("⚠️  Failed to remove %s from queue: %v", item.Title, err)
+			}
+			continue
+		}
+
+		deletedReason, dryRunReason := "deleted", "would be deleted"
+		if earlyIDs[item.ID] {
+			result.dropQueued(MediaTypeSeries, item.ID)
+			deletedReason, dryRunReason = "deleted early (disk pressure)", "would be deleted early (disk pressure)"
+		}
+
+		// Trashing on the same disk frees nothing, so disk pressure deletes outright
+		toTrash := cfg.Cleanup.Trash.Enabled && !earlyIDs[item.ID]
+		if toTrash {
+			deletedReason, dryRunReason = "moved to trash", "would be moved to trash"
+		}
+
+		if ruleAction(ruleByName(cfg.Cleanup.Rules, item.Rule)) == config.RuleActionUnmonitor {
+			s.keepSeriesFiles(ctx, result, queue, item, dryRun)
 			continue
 		}
 
@@ -212,66 +290,52 @@ func (s *Service) processSeriesRemovalQueue(ctx context.Context, result *Process
 				Type:       MediaTypeSeries,
 				Title:      item.Title,
 				ID:         item.ID,
+				ExternalID: item.ExternalID,
 				Action:     "dry_run_remove",
-				Reason:     "would be deleted",
+				Reason:     dryRunReason,
 				SizeOnDisk: sonarr.FormatSize(item.SizeOnDisk),
 			})
 		} else {
-			if err := s.sonarr.DeleteSeries(ctx, item.ID, true); err != nil {
+			if err := s.deleteSeries(ctx, ser, toTrash, cfg); err != nil {
 				logger.Errorf("❌ Failed to delete %s: %v", item.Title, err)
 				result.AddResult(MediaResult{
-					Type:   MediaTypeSeries,
-					Title:  item.Title,
-					ID:     item.ID,
-					Action: "error",
-					Reason: fmt.Sprintf("delete failed: %v", err),
+					Type:       MediaTypeSeries,
+					Title:      item.Title,
+					ID:         item.ID,
+					ExternalID: item.ExternalID,
+					Action:     "error",
+					Reason:     fmt.Sprintf("delete failed: %v", err),
 				})
 				continue
 			}
+			s.ledger.Add(seriesLedgerEntry(ser, deletedReason))
 			logger.Infof("✅ Deleted: %s (%s freed)", item.Title, sonarr.FormatSize(item.SizeOnDisk))
 			result.AddResult(MediaResult{
 				Type:       MediaTypeSeries,
 				Title:      item.Title,
 				ID:         item.ID,
+				ExternalID: item.ExternalID,
 				Action:     "removed",
-				Reason:     "deleted",
+				Reason:     deletedReason,
 				SizeOnDisk: sonarr.FormatSize(item.SizeOnDisk),
 			})
 		}
 
-		queue.Remove(item.ID)
+		if err := queue.Remove(item.ID); err != nil {
+			logger.Warnf("⚠️  Failed to remove %s from queue: %v", item.Title, err)
+		}
 	}
 }
 
-// checkWatchedOnDisk checks if user has watched all episodes on disk
-func checkWatchedOnDisk(ser *sonarr.Series, progress *trakt.ShowProgress) (bool, []int) {
-	var unwatchedSeasons []int
-
-	traktProgress := make(map[int]*trakt.SeasonProgress)
-	for i := range progress.Seasons {
-		traktProgress[progress.Seasons[i].Number] = &progress.Seasons[i]
-	}
-
+// sonarrSeasonFiles returns the number of episode files on disk per season
+func sonarrSeasonFiles(ser *sonarr.Series) map[int]int {
+	files := make(map[int]int)
 	for _, season := range ser.Seasons {
-		if season.SeasonNumber == 0 {
-			continue
-		}
-		if season.Statistics == nil || season.Statistics.EpisodeFileCount == 0 {
-			continue
-		}
-
-		traktSeason, found := traktProgress[season.SeasonNumber]
-		if !found {
-			unwatchedSeasons = append(unwatchedSeasons, season.SeasonNumber)
-			continue
-		}
-
-		if traktSeason.Completed < season.Statistics.EpisodeFileCount {
-			unwatchedSeasons = append(unwatchedSeasons, season.SeasonNumber)
+		if season.Statistics != nil && season.Statistics.EpisodeFileCount > 0 {
+			files[season.SeasonNumber] = season.Statistics.EpisodeFileCount
 		}
 	}
-
-	return len(unwatchedSeasons) == 0, unwatchedSeasons
+	return files
 }
 
 // checkMoreEpisodesComing checks if any season with files has more episodes to air
@@ -364,6 +428,92 @@ func getSeasonsWithFiles(ser *sonarr.Series) []int {
 	return seasons
 }
 
+// deleteSeries removes a series from Sonarr. With soft delete the series is
+// unmonitored, its folder moved to the trash, and Sonarr keeps its hands off
+// the files.
+func (s *Service) deleteSeries(ctx context.Context, ser *sonarr.Series, toTrash bool, cfg *config.Config) error {
+	if !toTrash {
+		return s.sonarr.DeleteSeries(ctx, ser.ID, true)
+	}
+
+	if err := s.sonarr.UnmonitorSeries(ctx, ser.ID); err != nil {
+		return fmt.Errorf("unmonitoring: %w", err)
+	}
+
+	trashed, err := s.moveToTrash(MediaTypeSeries, ser.TvdbID, ser.Title, ser.Path, ser.Statistics.SizeOnDisk, cfg)
+	if err != nil {
+		return err
+	}
+
+	if err := s.sonarr.DeleteSeries(ctx, ser.ID, false); err != nil {
+		// Put the folder back so Sonarr still finds its files
+		if restoreErr := s.restoreFromTrash(MediaTypeSeries, ser.TvdbID); restoreErr != nil {
+			logger.Errorf("❌ Failed to move %s back from trash: %v", ser.Title, restoreErr)
+		}
+		return err
+	}
+
+	logger.Infof("🗑️  Moved to trash: %s → %s", ser.Title, trashed.TrashPath)
+	return nil
+}
+
+// keepSeriesFiles handles a ready item whose rule only unmonitors: the series stays
+// in Sonarr with its files
+func (s *Service) keepSeriesFiles(ctx context.Context, result *ProcessingResult, queue *Queue, item *QueueItem, dryRun bool) {
+	res := MediaResult{
+		Type:       MediaTypeSeries,
+		Title:      item.Title,
+		ID:         item.ID,
+		ExternalID: item.ExternalID,
+		Action:     "skipped",
+		SizeOnDisk: sonarr.FormatSize(item.SizeOnDisk),
+	}
+
+	if dryRun {
+		logger.Warnf("🔕 [DRY RUN] Would unmonitor (keeping files): %s", item.Title)
+		res.Reason = fmt.Sprintf("would unmonitor, files kept (rule: %s)", item.Rule)
+	} else {
+		if err := s.sonarr.UnmonitorSeries(ctx, item.ID); err != nil {
+			logger.Errorf("❌ Failed to unmonitor %s: %v", item.Title, err)
+			res.Action = "error"
+			res.Reason = fmt.Sprintf("unmonitor failed: %v", err)
+			result.AddResult(res)
+			return
+		}
+		logger.Infof("🔕 Unmonitored (keeping files): %s", item.Title)
+		res.Reason = fmt.Sprintf("unmonitored, files kept (rule: %s)", item.Rule)
+	}
+
+	result.AddResult(res)
+	if err := queue.Remove(item.ID); err != nil {
+		logger.Warnf("⚠️  Failed to remove %s from queue: %v", item.Title, err)
+	}
+}
+
+// queuedSeriesOngoing re-checks a queued series for upcoming episodes.
+// Errors keep the series queued.
+func (s *Service) queuedSeriesOngoing(ctx context.Context, ser *sonarr.Series, viewers []*viewer, requireAll bool) (bool, string) {
+	verdict, err := evaluateSeries(ctx, viewers, requireAll, ser.TvdbID, func() (map[int]int, error) {
+		return sonarrSeasonFiles(ser), nil
+	})
+	if err != nil || verdict.progress == nil {
+		return false, ""
+	}
+	return checkMoreEpisodesComing(ser, verdict.progress, verdict.seasons)
+}
+
+// seriesMonitorState records a series' monitoring before it's unmonitored
+func seriesMonitorState(ser *sonarr.Series) *MonitorState {
+	state := &MonitorState{
+		Monitored: ser.Monitored,
+		Seasons:   make(map[int]bool, len(ser.Seasons)),
+	}
+	for _, season := range ser.Seasons {
+		state.Seasons[season.SeasonNumber] = season.Monitored
+	}
+	return state
+}
+
 // unmonitorSeries unmonitors a series in Sonarr when it's added to the cleanup queue
 func (s *Service) unmonitorSeries(ctx context.Context, seriesID int, title string, queue *Queue, dryRun bool) {
 	if dryRun {
@@ -377,5 +527,7 @@ func (s *Service) unmonitorSeries(ctx context.Context, seriesID int, title strin
 	}
 
 	logger.Infof("🔕 Unmonitored series: %s (queued for deletion)", title)
-	queue.MarkUnmonitored(seriesID)
+	if err := queue.MarkUnmonitored(seriesID); err != nil {
+		logger.Warnf("⚠️  Failed to save unmonitored state for %s: %v", title, err)
+	}
 }
@@ -0,0 +1,157 @@
+package cleanup
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"os"
+	"path/filepath"
+	"sync"
+
+	"github.com/fusionn-air/pkg/fileutil"
+	"github.com/fusionn-air/pkg/logger"
+)
+
+// QueueStore persists the cleanup queues
+type QueueStore interface {
+	// Load returns the saved items of one queue
+	Load(mediaType MediaType) ([]*QueueItem, error)
+	// Save replaces one queue's items; it either fully succeeds or changes nothing
+	Save(mediaType MediaType, items []*QueueItem) error
+}
+
+const queueStoreVersion = 1
+
+// queueFile is the on-disk layout of FileQueueStore
+type queueFile struct {
+	Version int                        `json:"version"`
+	Queues  map[MediaType][]*QueueItem `json:"queues"`
+}
+
+// legacyQueueFiles are the per-type JSON files used before FileQueueStore
+var legacyQueueFiles = map[MediaType]string{
+	MediaTypeSeries:         "cleanup_series_queue.json",
+	MediaTypeMovie:          "cleanup_movie_queue.json",
+	MediaTypeEmbySeries:     "cleanup_emby_series_queue.json",
+	MediaTypeEmbyMovie:      "cleanup_emby_movie_queue.json",
+	MediaTypeJellyfinSeries: "cleanup_jellyfin_series_queue.json",
+	MediaTypeJellyfinMovie:  "cleanup_jellyfin_movie_queue.json",
+	MediaTypePlexSeries:     "cleanup_plex_series_queue.json",
+	MediaTypePlexMovie:      "cleanup_plex_movie_queue.json",
+}
+
+// FileQueueStore keeps every queue in one JSON file, rewritten atomically
+// (temp file, fsync, rename) so a crash leaves either the old or new file
+type FileQueueStore struct {
+	mu     sync.Mutex
+	path   string
+	queues map[MediaType][]*QueueItem
+}
+
+// OpenFileQueueStore loads the queue file, migrating the legacy per-type
+// files next to it on first use. A corrupt queue file is an error rather
+// than an empty queue.
+func OpenFileQueueStore(path string) (*FileQueueStore, error) {
+	s := &FileQueueStore{
+		path:   path,
+		queues: make(map[MediaType][]*QueueItem),
+	}
+
+	data, err := os.ReadFile(path)
+	switch {
+	case err == nil:
+		var file queueFile
+		if err := json.Unmarshal(data, &file); err != nil {
+			return nil, fmt.Errorf("reading %s: %w", path, err)
+		}
+		if file.Version > queueStoreVersion {
+			return nil, fmt.Errorf("%s is version %d, newer than supported (%d)", path, file.Version, queueStoreVersion)
+		}
+		for t, items := range file.Queues {
+			s.queues[t] = items
+		}
+	case errors.Is(err, os.ErrNotExist):
+		if err := s.migrateLegacy(); err != nil {
+			return nil, err
+		}
+	default:
+		return nil, fmt.Errorf("reading %s: %w", path, err)
+	}
+
+	return s, nil
+}
+
+// Load returns the saved items of one queue
+func (s *FileQueueStore) Load(mediaType MediaType) ([]*QueueItem, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	return append([]*QueueItem(nil), s.queues[mediaType]...), nil
+}
+
+// Save replaces one queue's items and writes the file
+func (s *FileQueueStore) Save(mediaType MediaType, items []*QueueItem) error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	next := make(map[MediaType][]*QueueItem, len(s.queues)+1)
+	for t, existing := range s.queues {
+		next[t] = existing
+	}
+	next[mediaType] = items
+
+	if err := s.write(next); err != nil {
+		return err
+	}
+	s.queues = next
+	return nil
+}
+
+func (s *FileQueueStore) write(queues map[MediaType][]*QueueItem) error {
+	data, err := json.MarshalIndent(queueFile{Version: queueStoreVersion, Queues: queues}, "", "  ")
+	if err != nil {
+		return fmt.Errorf("encoding queues: %w", err)
+	}
+	return fileutil.WriteAtomic(s.path, data)
+}
+
+// migrateLegacy imports the per-type queue files, then renames them to
+// *.migrated so the import happens once
+func (s *FileQueueStore) migrateLegacy() error {
+	dir := filepath.Dir(s.path)
+
+	var migrated []string
+	for t, name := range legacyQueueFiles {
+		legacy := filepath.Join(dir, name)
+		data, err := os.ReadFile(legacy)
+		if errors.Is(err, os.ErrNotExist) {
+			continue
+		}
+		if err != nil {
+			return fmt.Errorf("reading %s: %w", legacy, err)
+		}
+
+		var items []*QueueItem
+		if err := json.Unmarshal(data, &items); err != nil {
+			return fmt.Errorf("migrating %s: %w", legacy, err)
+		}
+		s.queues[t] = items
+		migrated = append(migrated, legacy)
+	}
+
+	if len(migrated) == 0 {
+		return nil
+	}
+
+	if err := s.write(s.queues); err != nil {
+		return fmt.Errorf("migrating queues: %w", err)
+	}
+	for _, legacy := range migrated {
+		if err := os.Rename(legacy, legacy+".migrated"); err != nil {
+			logger.Warnf("⚠️  Migrated %s but couldn't rename it: %v", legacy, err)
+		}
+	}
+
+	logger.Infof("📋 Migrated %d queue file(s) into %s", len(migrated), s.path)
+	return nil
+}
@@ -17,6 +17,12 @@ func (s *Service) printSummary(result *ProcessingResult, startTime time.Time, dr
 	embySeriesResults := make(map[string][]MediaResult)
 	embyMovieResults := make(map[string][]MediaResult)
 
+	jellyfinSeriesResults := make(map[string][]MediaResult)
+	jellyfinMovieResults := make(map[string][]MediaResult)
+
+	plexSeriesResults := make(map[string][]MediaResult)
+	plexMovieResults := make(map[string][]MediaResult)
+
 	for _, r := range result.Results {
 		switch r.Type {
 		case MediaTypeSeries:
@@ -27,6 +33,14 @@ func (s *Service) printSummary(result *ProcessingResult, startTime time.Time, dr
 			embySeriesResults[r.Action] = append(embySeriesResults[r.Action], r)
 		case MediaTypeEmbyMovie:
 			embyMovieResults[r.Action] = append(embyMovieResults[r.Action], r)
+		case MediaTypeJellyfinSeries:
+			jellyfinSeriesResults[r.Action] = append(jellyfinSeriesResults[r.Action], r)
+		case MediaTypeJellyfinMovie:
+			jellyfinMovieResults[r.Action] = append(jellyfinMovieResults[r.Action], r)
+		case MediaTypePlexSeries:
+			plexSeriesResults[r.Action] = append(plexSeriesResults[r.Action], r)
+		case MediaTypePlexMovie:
+			plexMovieResults[r.Action] = append(plexMovieResults[r.Action], r)
 		}
 	}
 
@@ -39,6 +53,10 @@ func (s *Service) printSummary(result *ProcessingResult, startTime time.Time, dr
 	printMediaSection("🎬 MOVIES (Radarr)", movieResults, dryRun)
 	printMediaSection("📺 SERIES (Emby)", embySeriesResults, dryRun)
 	printMediaSection("🎬 MOVIES (Emby)", embyMovieResults, dryRun)
+	printMediaSection("📺 SERIES (Jellyfin)", jellyfinSeriesResults, dryRun)
+	printMediaSection("🎬 MOVIES (Jellyfin)", jellyfinMovieResults, dryRun)
+	printMediaSection("📺 SERIES (Plex)", plexSeriesResults, dryRun)
+	printMediaSection("🎬 MOVIES (Plex)", plexMovieResults, dryRun)
 
 	// Print per-type stats
 	logger.Info("")
@@ -182,9 +200,9 @@ func (s *Service) sendNotification(ctx context.Context, result *ProcessingResult
 
 func mediaIcon(t MediaType) string {
 	switch t {
-	case MediaTypeSeries, MediaTypeEmbySeries:
+	case MediaTypeSeries, MediaTypeEmbySeries, MediaTypeJellyfinSeries, MediaTypePlexSeries:
 		return "📺"
-	case MediaTypeMovie, MediaTypeEmbyMovie:
+	case MediaTypeMovie, MediaTypeEmbyMovie, MediaTypeJellyfinMovie, MediaTypePlexMovie:
 		return "🎬"
 	default:
 		return "📦"
@@ -0,0 +1,297 @@
+package cleanup
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"os"
+	"path/filepath"
+	"sort"
+	"strings"
+	"sync"
+	"syscall"
+	"time"
+
+	"github.com/fusionn-air/internal/client/sonarr"
+	"github.com/fusionn-air/internal/config"
+	"github.com/fusionn-air/pkg/fileutil"
+	"github.com/fusionn-air/pkg/logger"
+)
+
+// TrashItem is a folder moved to the trash directory instead of being deleted
+type TrashItem struct {
+	Type         MediaType `json:"type"`
+	ExternalID   int       `json:"external_id"` // TVDB for series, TMDB for movies
+	Title        string    `json:"title"`
+	OriginalPath string    `json:"original_path"` // Local path before trashing
+	TrashPath    string    `json:"trash_path"`
+	SizeOnDisk   int64     `json:"size_on_disk"`
+	TrashedAt    time.Time `json:"trashed_at"`
+}
+
+// Trash persists the contents of the trash directory
+type Trash struct {
+	mu       sync.RWMutex
+	items    map[string]*TrashItem // keyed by trash path
+	filePath string
+}
+
+// NewTrashWithFile creates a new trash index with a specific file path
+func NewTrashWithFile(path string) *Trash {
+	t := &Trash{
+		items:    make(map[string]*TrashItem),
+		filePath: path,
+	}
+	_ = t.load()
+	return t
+}
+
+// Add records a trashed folder
+func (t *Trash) Add(item *TrashItem) {
+	t.mu.Lock()
+	defer t.mu.Unlock()
+
+	t.items[item.TrashPath] = item
+	_ = t.save()
+}
+
+// Remove drops a trashed folder from the index
+func (t *Trash) Remove(trashPath string) {
+	t.mu.Lock()
+	defer t.mu.Unlock()
+
+	delete(t.items, trashPath)
+	_ = t.save()
+}
+
+// Find returns the most recently trashed folder for an item, or nil
+func (t *Trash) Find(mediaType MediaType, externalID int) *TrashItem {
+	t.mu.RLock()
+	defer t.mu.RUnlock()
+
+	var found *TrashItem
+	for _, item := range t.items {
+		if item.Type == mediaType && item.ExternalID == externalID {
+			if found == nil || item.TrashedAt.After(found.TrashedAt) {
+				found = item
+			}
+		}
+	}
+	return found
+}
+
+// GetAll returns all trashed folders, most recent first
+func (t *Trash) GetAll() []*TrashItem {
+	t.mu.RLock()
+	defer t.mu.RUnlock()
+
+	items := make([]*TrashItem, 0, len(t.items))
+	for _, item := range t.items {
+		items = append(items, item)
+	}
+	sort.Slice(items, func(i, j int) bool {
+		return items[i].TrashedAt.After(items[j].TrashedAt)
+	})
+	return items
+}
+
+// load reads the trash index from disk
+func (t *Trash) load() error {
+	data, err := os.ReadFile(t.filePath)
+	if err != nil {
+		return err
+	}
+
+	var items []*TrashItem
+	if err := json.Unmarshal(data, &items); err != nil {
+		return err
+	}
+
+	for _, item := range items {
+		t.items[item.TrashPath] = item
+	}
+
+	return nil
+}
+
+// save writes the trash index to disk
+func (t *Trash) save() error {
+	items := make([]*TrashItem, 0, len(t.items))
+	for _, item := range t.items {
+		items = append(items, item)
+	}
+
+	data, err := json.MarshalIndent(items, "", "  ")
+	if err != nil {
+		return err
+	}
+
+	return fileutil.WriteAtomic(t.filePath, data)
+}
+
+// GetTrash returns the folders currently in the trash
+func (s *Service) GetTrash() []*TrashItem {
+	return s.trash.GetAll()
+}
+
+// moveToTrash moves an item's folder into the trash directory and records it
+func (s *Service) moveToTrash(mediaType MediaType, externalID int, title, remotePath string, size int64, cfg *config.Config) (*TrashItem, error) {
+	trash := cfg.Cleanup.Trash
+	if trash.Path == "" {
+		return nil, errors.New("cleanup.trash.path is not set")
+	}
+
+	src := localPath(trash.PathMappings, remotePath)
+	if _, err := os.Stat(src); err != nil {
+		return nil, fmt.Errorf("folder not accessible (check path_mappings): %w", err)
+	}
+
+	dest := filepath.Join(trash.Path, string(mediaType), filepath.Base(src))
+	if _, err := os.Stat(dest); err == nil {
+		dest = fmt.Sprintf("%s (%s)", dest, time.Now().Format("20060102-150405"))
+	}
+
+	if err := moveDir(src, dest); err != nil {
+		return nil, fmt.Errorf("moving to trash: %w", err)
+	}
+
+	item := &TrashItem{
+		Type:         mediaType,
+		ExternalID:   externalID,
+		Title:        title,
+		OriginalPath: src,
+		TrashPath:    dest,
+		SizeOnDisk:   size,
+		TrashedAt:    time.Now(),
+	}
+	s.trash.Add(item)
+	return item, nil
+}
+
+// restoreFromTrash moves a trashed folder back to where it came from
+func (s *Service) restoreFromTrash(mediaType MediaType, externalID int) error {
+	item := s.trash.Find(mediaType, externalID)
+	if item == nil {
+		return nil
+	}
+
+	if _, err := os.Stat(item.OriginalPath); err == nil {
+		return fmt.Errorf("%s already exists", item.OriginalPath)
+	}
+
+	if err := moveDir(item.TrashPath, item.OriginalPath); err != nil {
+		return fmt.Errorf("moving out of trash: %w", err)
+	}
+
+	s.trash.Remove(item.TrashPath)
+	logger.Infof("♻️  Moved %s back from trash", item.Title)
+	return nil
+}
+
+// purgeTrash permanently deletes trashed folders older than retention_days.
+// Runs even with trash disabled, so folders trashed earlier don't linger.
+func (s *Service) purgeTrash(cfg *config.Config, dryRun bool) {
+	trash := cfg.Cleanup.Trash
+	if trash.RetentionDays <= 0 {
+		return
+	}
+
+	cutoff := time.Now().AddDate(0, 0, -trash.RetentionDays)
+	for _, item := range s.trash.GetAll() {
+		if item.TrashedAt.After(cutoff) {
+			continue
+		}
+
+		if dryRun {
+			logger.Warnf("🗑️  [DRY RUN] Would purge from trash: %s (%s)", item.Title, sonarr.FormatSize(item.SizeOnDisk))
+			continue
+		}
+
+		if err := os.RemoveAll(item.TrashPath); err != nil {
+			logger.Errorf("❌ Failed to purge %s from trash: %v", item.Title, err)
+			continue
+		}
+
+		s.trash.Remove(item.TrashPath)
+		logger.Infof("✅ Purged from trash: %s (%s freed)", item.Title, sonarr.FormatSize(item.SizeOnDisk))
+	}
+}
+
+// localPath translates a Sonarr/Radarr path using the longest matching mapping
+func localPath(mappings []config.PathMapping, remote string) string {
+	var best config.PathMapping
+	for _, m := range mappings {
+		prefix := strings.TrimRight(m.Remote, `/\`)
+		if prefix == "" {
+			continue
+		}
+		inside := remote == prefix || strings.HasPrefix(remote, prefix+"/") || strings.HasPrefix(remote, prefix+`\`)
+		if inside && len(prefix) > len(strings.TrimRight(best.Remote, `/\`)) {
+			best = m
+		}
+	}
+	if best.Remote == "" {
+		return remote
+	}
+
+	rest := strings.TrimPrefix(remote, strings.TrimRight(best.Remote, `/\`))
+	return filepath.Join(best.Local, filepath.FromSlash(strings.ReplaceAll(rest, `\`, "/")))
+}
+
+// moveDir renames src to dest, copying across filesystems when needed
+func moveDir(src, dest string) error {
+	if err := os.MkdirAll(filepath.Dir(dest), 0o755); err != nil {
+		return err
+	}
+
+	err := os.Rename(src, dest)
+	if err == nil || !errors.Is(err, syscall.EXDEV) {
+		return err
+	}
+
+	if err := copyDir(src, dest); err != nil {
+		_ = os.RemoveAll(dest)
+		return err
+	}
+	return os.RemoveAll(src)
+}
+
+// copyDir recursively copies a directory tree
+func copyDir(src, dest string) error {
+	return filepath.Walk(src, func(path string, info os.FileInfo, err error) error {
+		if err != nil {
+			return err
+		}
+
+		rel, err := filepath.Rel(src, path)
+		if err != nil {
+			return err
+		}
+		target := filepath.Join(dest, rel)
+
+		if info.IsDir() {
+			return os.MkdirAll(target, info.Mode().Perm())
+		}
+		return copyFile(path, target, info.Mode().Perm())
+	})
+}
+
+func copyFile(src, dest string, perm os.FileMode) error {
+	in, err := os.Open(src)
+	if err != nil {
+		return err
+	}
+	defer in.Close()
+
+	out, err := os.OpenFile(dest, os.O_CREATE|os.O_WRONLY|os.O_TRUNC, perm)
+	if err != nil {
+		return err
+	}
+
+	if _, err := io.Copy(out, in); err != nil {
+		out.Close()
+		return err
+	}
+	return out.Close()
+}
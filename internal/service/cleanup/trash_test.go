@@ -0,0 +1,87 @@
+package cleanup
+
+import (
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/fusionn-air/internal/config"
+)
+
+func TestLocalPath(t *testing.T) {
+	mappings := []config.PathMapping{
+		{Remote: "/tv", Local: "/media/tv"},
+		{Remote: "/tv/anime/", Local: "/media/anime"},
+		{Remote: `D:\Movies`, Local: "/media/movies"},
+		{Remote: "", Local: "/ignored"},
+	}
+
+	tests := []struct {
+		name   string
+		remote string
+		want   string
+	}{
+		{"no mapping", "/downloads/Show", "/downloads/Show"},
+		{"prefix mapping", "/tv/Breaking Bad", filepath.Join("/media/tv", "Breaking Bad")},
+		{"longest prefix wins", "/tv/anime/Naruto", filepath.Join("/media/anime", "Naruto")},
+		{"mapping root itself", "/tv", "/media/tv"},
+		{"partial segment doesn't match", "/tvshows/Show", "/tvshows/Show"},
+		{"windows path", `D:\Movies\The Matrix (1999)`, filepath.Join("/media/movies", "The Matrix (1999)")},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := localPath(mappings, tt.remote); got != tt.want {
+				t.Errorf("localPath(%q) = %q, want %q", tt.remote, got, tt.want)
+			}
+		})
+	}
+}
+
+func TestPurgeTrash(t *testing.T) {
+	tests := []struct {
+		name       string
+		trash      config.TrashConfig
+		dryRun     bool
+		wantPurged bool
+	}{
+		{"enabled", config.TrashConfig{Enabled: true, RetentionDays: 7}, false, true},
+		{"disabled after trashing", config.TrashConfig{RetentionDays: 7}, false, true},
+		{"dry run", config.TrashConfig{Enabled: true, RetentionDays: 7}, true, false},
+		{"kept forever", config.TrashConfig{Enabled: true}, false, false},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			dir := t.TempDir()
+			s := &Service{trash: NewTrashWithFile(filepath.Join(dir, "trash.json"))}
+
+			old := filepath.Join(dir, "old")
+			recent := filepath.Join(dir, "recent")
+			for _, path := range []string{old, recent} {
+				if err := os.Mkdir(path, 0o755); err != nil {
+					t.Fatal(err)
+				}
+			}
+			s.trash.Add(&TrashItem{Title: "Old", TrashPath: old, TrashedAt: time.Now().AddDate(0, 0, -8)})
+			s.trash.Add(&TrashItem{Title: "Recent", TrashPath: recent, TrashedAt: time.Now().AddDate(0, 0, -1)})
+
+			s.purgeTrash(&config.Config{Cleanup: config.CleanupConfig{Trash: tt.trash}}, tt.dryRun)
+
+			if _, err := os.Stat(old); os.IsNotExist(err) != tt.wantPurged {
+				t.Errorf("old folder purged = %v, want %v", os.IsNotExist(err), tt.wantPurged)
+			}
+			if _, err := os.Stat(recent); err != nil {
+				t.Errorf("recent folder purged: %v", err)
+			}
+			if got, want := len(s.trash.GetAll()), 2; tt.wantPurged {
+				if got != want-1 {
+					t.Errorf("trash index has %d items, want %d", got, want-1)
+				}
+			} else if got != want {
+				t.Errorf("trash index has %d items, want %d", got, want)
+			}
+		})
+	}
+}
@@ -0,0 +1,88 @@
+package watcher
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	"github.com/fusionn-air/internal/client/trakt"
+	"github.com/fusionn-air/internal/config"
+	"github.com/fusionn-air/pkg/logger"
+)
+
+const primaryAccountName = "me"
+
+// account is a Trakt account whose calendar and progress can trigger requests
+type account struct {
+	name            string
+	trakt           *trakt.Client
+	overseerrUserID int // 0 = overseerr.user_id
+}
+
+// accounts returns the Trakt accounts to process this run, primary first
+func (s *Service) accounts(cfg *config.Config) []*account {
+	primaryName := cfg.Trakt.Name
+	if primaryName == "" {
+		primaryName = primaryAccountName
+	}
+
+	all := []*account{{name: primaryName, trakt: s.trakt}}
+	for _, c := range s.traktUsers {
+		acct := &account{name: c.Name(), trakt: c}
+		// Looked up per run so overseerr_user_id changes apply without restart
+		for _, u := range cfg.Trakt.Users {
+			if strings.EqualFold(u.Name, c.Name()) {
+				acct.overseerrUserID = u.OverseerrUserID
+				break
+			}
+		}
+		all = append(all, acct)
+	}
+	return all
+}
+
+// fetchCalendars merges every account's calendar, grouped by show and season.
+// Each item lists the accounts that have it on their calendar. Only a failure
+// for the primary account is fatal.
+func (s *Service) fetchCalendars(ctx context.Context, accounts []*account, days int) (map[string]calendarItem, error) {
+	merged := make(map[string]calendarItem)
+
+	for i, acct := range accounts {
+		items, err := acct.trakt.GetMyShowsCalendar(ctx, days)
+		if err != nil {
+			if i == 0 {
+				return nil, err
+			}
+			logger.Errorf("❌ Failed to get calendar for %s: %v", acct.name, err)
+			continue
+		}
+
+		for key, item := range s.groupByShowAndSeason(items) {
+			existing, exists := merged[key]
+			if !exists {
+				existing = item
+			}
+			existing.accounts = append(existing.accounts, acct)
+			merged[key] = existing
+		}
+	}
+
+	return merged, nil
+}
+
+// accountNames joins account names for log lines
+func accountNames(accounts []*account) string {
+	names := make([]string, len(accounts))
+	for i, acct := range accounts {
+		names[i] = acct.name
+	}
+	return strings.Join(names, ", ")
+}
+
+// accountReason prefixes a reason with the account name when several accounts are configured
+func accountReason(multiUser bool, acct *account, reason string) string {
+	if !multiUser {
+		return reason
+	}
+	return fmt.Sprintf("%s: %s", acct.name, reason)
+}
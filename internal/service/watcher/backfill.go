@@ -0,0 +1,246 @@
+package watcher
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/fusionn-air/internal/client/overseerr"
+	"github.com/fusionn-air/internal/client/trakt"
+	"github.com/fusionn-air/internal/config"
+	"github.com/fusionn-air/pkg/logger"
+)
+
+// watchedShow is a show someone is watching, with the account that watched it
+type watchedShow struct {
+	show trakt.Show
+	acct *account
+}
+
+// ProcessBackfill requests aired seasons of watched shows that Overseerr has
+// neither available nor requested, e.g. seasons that were never on the
+// calendar or were lost from Sonarr
+func (s *Service) ProcessBackfill(ctx context.Context) ([]ProcessResult, error) {
+	// Get fresh config for this run (supports hot-reload)
+	cfg := s.cfgMgr.Get()
+	backfill := cfg.Watcher.Backfill
+	if !backfill.Enabled {
+		return nil, nil
+	}
+	dryRun := cfg.Scheduler.DryRun
+
+	startTime := time.Now()
+
+	logger.Info("")
+	logger.Info("╔══════════════════════════════════════════════════════════════╗")
+	logger.Info("║              BACKFILL PROCESSING STARTED                     ║")
+	logger.Info("╚══════════════════════════════════════════════════════════════╝")
+
+	if dryRun {
+		logger.Warn("⚠️  DRY RUN MODE - No actual requests will be made")
+	}
+
+	accounts := s.accounts(cfg)
+	multiUser := len(accounts) > 1
+
+	shows, err := s.fetchWatchedShows(ctx, accounts, backfill.ActiveDays)
+	if err != nil {
+		logger.Errorf("❌ Failed to get watched shows: %v", err)
+		s.recordRun(nil, startTime, dryRun, err)
+		return nil, fmt.Errorf("getting watched shows: %w", err)
+	}
+
+	if len(shows) == 0 {
+		logger.Info("📭 No recently watched shows")
+		s.recordRun(nil, startTime, dryRun, nil)
+		return nil, nil
+	}
+
+	logger.Infof("📺 Checking %d watched shows for missing seasons", len(shows))
+	logger.Info("")
+
+	routes := &router{routing: cfg.Watcher.Routing, lists: newListIndex(accounts, cfg.Watcher.Routing)}
+
+	var results []ProcessResult
+	for _, item := range shows {
+		results = append(results, s.backfillShow(ctx, item, cfg, routes, dryRun, multiUser)...)
+	}
+
+	if len(results) == 0 {
+		logger.Info("✅ No missing seasons")
+		s.recordRun(nil, startTime, dryRun, nil)
+		return nil, nil
+	}
+
+	s.recordRun(results, startTime, dryRun, nil)
+	s.printSummary(results, startTime, dryRun)
+	s.sendNotification(ctx, "📺 Watcher Backfill", results, dryRun)
+
+	return results, nil
+}
+
+// BackfillEnabled reports whether watcher.backfill is turned on
+func (s *Service) BackfillEnabled() bool {
+	return s.cfgMgr.Get().Watcher.Backfill.Enabled
+}
+
+// fetchWatchedShows merges every account's watched shows, keeping the first
+// account that watched each one. Only a failure for the primary account is fatal.
+func (s *Service) fetchWatchedShows(ctx context.Context, accounts []*account, activeDays int) ([]watchedShow, error) {
+	var shows []watchedShow
+	seen := make(map[int]bool)
+
+	for i, acct := range accounts {
+		watched, err := acct.trakt.GetWatchedShows(ctx)
+		if err != nil {
+			if i == 0 {
+				return nil, err
+			}
+			logger.Errorf("❌ Failed to get watched shows for %s: %v", acct.name, err)
+			continue
+		}
+
+		for _, w := range watched {
+			if activeDays > 0 && time.Since(w.LastWatchedAt) > time.Duration(activeDays)*24*time.Hour {
+				continue
+			}
+			if seen[w.Show.IDs.Trakt] {
+				continue
+			}
+			seen[w.Show.IDs.Trakt] = true
+			shows = append(shows, watchedShow{show: w.Show, acct: acct})
+		}
+	}
+
+	return shows, nil
+}
+
+// backfillShow requests the show's aired seasons missing from Overseerr
+// that the account has started or has yet to watch, mapped from Trakt to
+// TMDB numbering. Finished seasons and ones cleanup deleted are skipped.
+// Returns one result per missing season and per declined or failed request;
+// nothing when everything is requested or available.
+func (s *Service) backfillShow(ctx context.Context, item watchedShow, cfg *config.Config, routes *router, dryRun, multiUser bool) []ProcessResult {
+	show := item.show
+	if show.IDs.TMDB == 0 {
+		logger.Debugf("Skipping backfill for %s (no TMDB ID)", show.Title)
+		return nil
+	}
+
+	user := ""
+	if multiUser {
+		user = item.acct.name
+	}
+	failed := func(err string) []ProcessResult {
+		return []ProcessResult{{
+			ShowTitle: show.Title,
+			ShowTMDB:  show.IDs.TMDB,
+			Action:    "error",
+			Error:     err,
+			User:      user,
+		}}
+	}
+
+	progress, err := item.acct.trakt.GetShowProgress(ctx, show.IDs.Trakt)
+	if err != nil {
+		return failed(fmt.Sprintf("failed to get progress: %v", err))
+	}
+	// Premiere dates for mapping; without them Trakt numbering is used as is
+	traktSeasons, _ := item.acct.trakt.GetShowSeasons(ctx, show.IDs.Trakt)
+
+	tvDetails, err := s.overseerr.GetTVByTMDB(ctx, show.IDs.TMDB)
+	if err != nil {
+		return failed(fmt.Sprintf("Overseerr error: %v", err))
+	}
+
+	// Walk Overseerr's own season list so seasons are numbered the way it
+	// expects them, judging each by the Trakt progress mapped onto it
+	watched := tmdbProgress(tvDetails, progress, traktSeasons)
+
+	var results []ProcessResult
+	var missing []overseerr.TVSeason
+	handled := make(map[int]bool) // Declined/failed request IDs, one result each
+	for _, season := range airedSeasons(tvDetails, cfg.Watcher.Policy.Specials) {
+		// Only seasons Trakt has aired episodes for, with some left to watch
+		p := watched[season.SeasonNumber]
+		if p == nil || p.completed >= p.aired {
+			continue
+		}
+		if s.deletedByCleanup(show, p.traktSeasons) {
+			logger.Debugf("Skipping backfill for %s S%02d (deleted by cleanup)", show.Title, season.SeasonNumber)
+			continue
+		}
+
+		info := s.overseerr.GetSeasonRequestInfo(tvDetails, season.SeasonNumber)
+		if info.Requested || handled[info.RequestID] {
+			continue
+		}
+		if info.RequestID != 0 {
+			handled[info.RequestID] = true
+			result := ProcessResult{
+				ShowTitle: show.Title,
+				ShowTMDB:  show.IDs.TMDB,
+				Season:    season.SeasonNumber,
+				User:      user,
+			}
+			s.existingRequest(ctx, info, cfg.Watcher.RetryFailed, dryRun, &result)
+			results = append(results, result)
+			continue
+		}
+		missing = append(missing, season)
+	}
+	if len(missing) == 0 {
+		return results
+	}
+
+	// Calendar items carry the details routing rules match on; watched shows don't
+	if details, err := item.acct.trakt.GetShow(ctx, show.IDs.Trakt); err == nil {
+		show = *details
+	}
+	route := routes.route(ctx, showMedia(show))
+
+	requested := make([]ProcessResult, len(missing))
+	seasons := make([]int, len(missing))
+	for i, season := range missing {
+		seasons[i] = season.SeasonNumber
+		requested[i] = ProcessResult{
+			ShowTitle: show.Title,
+			ShowTMDB:  show.IDs.TMDB,
+			Season:    season.SeasonNumber,
+			Action:    "requested",
+			Reason:    fmt.Sprintf("backfill: aired %s, %d/%d eps watched, not in Overseerr", season.AirDate, watched[season.SeasonNumber].completed, watched[season.SeasonNumber].aired),
+			Route:     route.name,
+			User:      user,
+		}
+	}
+
+	// In dry-run mode, don't actually request
+	if dryRun {
+		for i := range requested {
+			requested[i].Action = "dry_run"
+		}
+		return append(results, requested...)
+	}
+
+	if _, err := s.overseerr.RequestTVAs(ctx, item.acct.overseerrUserID, show.IDs.TMDB, seasons, route.opts); err != nil {
+		for i := range requested {
+			requested[i].Action = "error"
+			requested[i].Error = fmt.Sprintf("request failed: %v", err)
+		}
+	}
+	return append(results, requested...)
+}
+
+// deletedByCleanup reports whether cleanup deliberately deleted any of the
+// show's Trakt seasons (Sonarr numbers seasons the same way)
+func (s *Service) deletedByCleanup(show trakt.Show, traktSeasons []int) bool {
+	if s.deletions == nil || show.IDs.TVDB == 0 {
+		return false
+	}
+	for _, season := range traktSeasons {
+		if s.deletions.SeasonDeleted(show.IDs.TVDB, season) {
+			return true
+		}
+	}
+	return false
+}
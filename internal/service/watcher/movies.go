@@ -0,0 +1,159 @@
+package watcher
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/fusionn-air/internal/client/trakt"
+	"github.com/fusionn-air/internal/config"
+	"github.com/fusionn-air/pkg/logger"
+)
+
+// mediaTypeMovie marks movie results; calendar results leave MediaType empty
+const mediaTypeMovie = "movie"
+
+// movieItem is a movie to consider requesting, with why it was picked
+type movieItem struct {
+	movie  trakt.Movie
+	source string   // "on watchlist", "anticipated #3", ...
+	acct   *account // Account whose watchlist it's on (nil for charts)
+}
+
+// fetchMovies collects watchlist movies from every account, then the
+// configured anticipated/trending charts, deduplicated by TMDB ID. Only a
+// failure for the primary account's watchlist is fatal.
+func (s *Service) fetchMovies(ctx context.Context, accounts []*account, movies config.MoviesConfig, multiUser bool) ([]movieItem, error) {
+	var items []movieItem
+	seen := make(map[int]bool)
+	add := func(movie trakt.Movie, source string, acct *account) {
+		if movie.IDs.TMDB != 0 && seen[movie.IDs.TMDB] {
+			return
+		}
+		seen[movie.IDs.TMDB] = true
+		items = append(items, movieItem{movie: movie, source: source, acct: acct})
+	}
+
+	for i, acct := range accounts {
+		watchlist, err := acct.trakt.GetMovieWatchlist(ctx)
+		if err != nil {
+			if i == 0 {
+				return nil, err
+			}
+			logger.Errorf("❌ Failed to get watchlist for %s: %v", acct.name, err)
+			continue
+		}
+		for _, entry := range watchlist {
+			if entry.Movie != nil {
+				add(*entry.Movie, accountReason(multiUser, acct, "on watchlist"), acct)
+			}
+		}
+	}
+
+	primary := accounts[0].trakt
+	if movies.Anticipated > 0 {
+		chart, err := primary.GetAnticipatedMovies(ctx, movies.Anticipated)
+		if err != nil {
+			logger.Errorf("❌ Failed to get anticipated movies: %v", err)
+		}
+		for i, entry := range chart {
+			add(entry.Movie, fmt.Sprintf("anticipated #%d", i+1), nil)
+		}
+	}
+	if movies.Trending > 0 {
+		chart, err := primary.GetTrendingMovies(ctx, movies.Trending)
+		if err != nil {
+			logger.Errorf("❌ Failed to get trending movies: %v", err)
+		}
+		for i, entry := range chart {
+			add(entry.Movie, fmt.Sprintf("trending #%d (%d watching)", i+1, entry.Watchers), nil)
+		}
+	}
+
+	return items, nil
+}
+
+// processMovies requests watchlist and chart movies that Overseerr doesn't
+// have yet
+func (s *Service) processMovies(ctx context.Context, cfg *config.Config, accounts []*account, lists *listIndex, dryRun, multiUser bool) []ProcessResult {
+	movies := cfg.Watcher.Movies
+	routes := &router{routing: movies.Routing, lists: lists}
+	retry := cfg.Watcher.RetryFailed
+
+	logger.Info("🎬 Fetching movie watchlists...")
+	items, err := s.fetchMovies(ctx, accounts, movies, multiUser)
+	if err != nil {
+		logger.Errorf("❌ Failed to get movie watchlist: %v", err)
+		return nil
+	}
+
+	if len(items) == 0 {
+		logger.Info("📭 No movies on watchlists")
+		return nil
+	}
+
+	logger.Infof("🎬 Found %d movies to check", len(items))
+	logger.Info("")
+
+	var results []ProcessResult
+	for _, item := range items {
+		results = append(results, s.processMovie(ctx, item, dryRun, routes, retry, multiUser))
+	}
+	return results
+}
+
+func (s *Service) processMovie(ctx context.Context, item movieItem, dryRun bool, routes *router, retry config.RetryFailedConfig, multiUser bool) ProcessResult {
+	result := ProcessResult{
+		MediaType: mediaTypeMovie,
+		ShowTitle: item.movie.Title,
+		ShowTMDB:  item.movie.IDs.TMDB,
+		Year:      item.movie.Year,
+	}
+
+	// Determine routing
+	route := routes.route(ctx, movieMedia(item.movie))
+	result.Route = route.name
+
+	if item.movie.IDs.TMDB == 0 {
+		result.Action = "skipped"
+		result.Reason = "no TMDB ID"
+		return result
+	}
+
+	userID := 0
+	if item.acct != nil {
+		userID = item.acct.overseerrUserID
+		if multiUser {
+			result.User = item.acct.name
+		}
+	}
+
+	// Check Overseerr if already requested/available
+	details, err := s.overseerr.GetMovieByTMDB(ctx, item.movie.IDs.TMDB)
+	if err != nil {
+		result.Action = "error"
+		result.Error = fmt.Sprintf("Overseerr error: %v", err)
+		return result
+	}
+
+	requestInfo := s.overseerr.GetMovieRequestInfo(details)
+	if s.existingRequest(ctx, requestInfo, retry, dryRun, &result) {
+		return result
+	}
+
+	// In dry-run mode, don't actually request
+	if dryRun {
+		result.Action = "dry_run"
+		result.Reason = item.source
+		return result
+	}
+
+	if _, err := s.overseerr.RequestMovieAs(ctx, userID, item.movie.IDs.TMDB, route.opts); err != nil {
+		result.Action = "error"
+		result.Error = fmt.Sprintf("request failed: %v", err)
+		return result
+	}
+
+	result.Action = "requested"
+	result.Reason = item.source
+	return result
+}
@@ -0,0 +1,89 @@
+package watcher
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/fusionn-air/internal/client/overseerr"
+	"github.com/fusionn-air/internal/config"
+)
+
+// defaultRetryCooldownHours is used when retry_failed.cooldown_hours is unset
+const defaultRetryCooldownHours = 24
+
+// existingRequest fills in the result for a season or movie Overseerr
+// already has a request for, or has available. It reports false when
+// nothing is in the way of a new request.
+func (s *Service) existingRequest(ctx context.Context, info overseerr.SeasonRequestInfo, retry config.RetryFailedConfig, dryRun bool, result *ProcessResult) bool {
+	if info.Requested {
+		status := ""
+		if info.RequestID != 0 {
+			status = fmt.Sprintf(" (%s)", info.RequestStatus)
+		}
+
+		result.Action = "already_requested"
+		if info.RequestedBy != "" {
+			result.Reason = fmt.Sprintf("already requested by %s%s", info.RequestedBy, status)
+		} else if info.Status >= overseerr.MediaStatusPartiallyAvail {
+			result.Reason = "already available in Overseerr"
+		} else {
+			result.Reason = "already requested in Overseerr" + status
+		}
+		return true
+	}
+
+	switch info.RequestStatus {
+	case overseerr.RequestStatusDeclined:
+		result.Action = "declined"
+		if info.RequestedBy != "" {
+			result.Reason = fmt.Sprintf("request #%d by %s was declined in Overseerr", info.RequestID, info.RequestedBy)
+		} else {
+			result.Reason = fmt.Sprintf("request #%d was declined in Overseerr", info.RequestID)
+		}
+		return true
+	case overseerr.RequestStatusFailed:
+		s.retryFailed(ctx, info, retry, dryRun, result)
+		return true
+	}
+	return false
+}
+
+// retryFailed re-submits a failed request once its cooldown has passed
+func (s *Service) retryFailed(ctx context.Context, info overseerr.SeasonRequestInfo, retry config.RetryFailedConfig, dryRun bool, result *ProcessResult) {
+	failed := fmt.Sprintf("request #%d failed in Overseerr", info.RequestID)
+
+	if !retry.Enabled {
+		result.Action = "failed"
+		result.Error = failed
+		return
+	}
+
+	cooldown := retry.CooldownHours
+	if cooldown <= 0 {
+		cooldown = defaultRetryCooldownHours
+	}
+	if wait := time.Until(info.UpdatedAt.Add(time.Duration(cooldown) * time.Hour)); wait > 0 {
+		result.Action = "failed"
+		result.Error = fmt.Sprintf("%s (retrying in %s)", failed, wait.Round(time.Minute))
+		return
+	}
+
+	reason := fmt.Sprintf("retrying failed request #%d", info.RequestID)
+
+	// In dry-run mode, don't actually retry
+	if dryRun {
+		result.Action = "dry_run"
+		result.Reason = reason
+		return
+	}
+
+	if err := s.overseerr.RetryRequest(ctx, info.RequestID); err != nil {
+		result.Action = "error"
+		result.Error = fmt.Sprintf("%s, retry failed: %v", failed, err)
+		return
+	}
+
+	result.Action = "requested"
+	result.Reason = reason
+}
@@ -0,0 +1,258 @@
+package watcher
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	"github.com/fusionn-air/internal/client/overseerr"
+	"github.com/fusionn-air/internal/client/trakt"
+	"github.com/fusionn-air/internal/config"
+	"github.com/fusionn-air/pkg/logger"
+)
+
+// route is where a request goes and with which settings
+type route struct {
+	name string // Rule name, "default", "alternate", or "" (no routing configured)
+	opts overseerr.RequestOptions
+}
+
+// routeMedia is what routing rules match against
+type routeMedia struct {
+	traktID       int
+	tmdbID        int
+	title         string
+	movie         bool
+	genres        []string
+	country       string
+	network       string
+	language      string
+	year          int
+	certification string
+}
+
+func showMedia(show trakt.Show) routeMedia {
+	return routeMedia{
+		traktID:       show.IDs.Trakt,
+		tmdbID:        show.IDs.TMDB,
+		title:         show.Title,
+		genres:        show.Genres,
+		country:       show.Country,
+		network:       show.Network,
+		language:      show.Language,
+		year:          show.Year,
+		certification: show.Certification,
+	}
+}
+
+func movieMedia(movie trakt.Movie) routeMedia {
+	return routeMedia{
+		traktID:       movie.IDs.Trakt,
+		tmdbID:        movie.IDs.TMDB,
+		title:         movie.Title,
+		movie:         true,
+		genres:        movie.Genres,
+		country:       movie.Country,
+		language:      movie.Language,
+		year:          movie.Year,
+		certification: movie.Certification,
+	}
+}
+
+// router applies a routing config: the ordered rules first, then the
+// alternate/default servers, then any per-show override
+type router struct {
+	routing config.RoutingConfig
+	lists   *listIndex
+}
+
+func (r *router) route(ctx context.Context, m routeMedia) route {
+	rt := r.match(ctx, m)
+
+	for _, o := range r.routing.Overrides {
+		if (o.TMDB == 0 || o.TMDB != m.tmdbID) && (o.Title == "" || !strings.EqualFold(o.Title, m.title)) {
+			continue
+		}
+		if o.ServerID != nil {
+			id := *o.ServerID
+			rt.opts.ServerID = &id
+		}
+		rt.opts = withSettings(rt.opts, o.RequestSettings)
+		if rt.name == "" {
+			rt.name = "override"
+		} else {
+			rt.name += " + override"
+		}
+		break
+	}
+
+	return rt
+}
+
+// match picks the route before overrides
+func (r *router) match(ctx context.Context, m routeMedia) route {
+	for i, rule := range r.routing.Rules {
+		if !r.matches(ctx, rule, m) {
+			continue
+		}
+		name := rule.Name
+		if name == "" {
+			name = fmt.Sprintf("rule %d", i+1)
+		}
+		id := rule.ServerID
+		return route{
+			name: name,
+			opts: withSettings(overseerr.RequestOptions{ServerID: &id}, rule.RequestSettings),
+		}
+	}
+
+	serverID, name := determineServerID(m.genres, m.country, r.routing)
+	settings := r.routing.Default
+	if name == "alternate" {
+		settings = r.routing.Alternate
+	}
+	return route{name: name, opts: withSettings(overseerr.RequestOptions{ServerID: serverID}, settings)}
+}
+
+// withSettings applies the settings that are set on top of opts
+func withSettings(opts overseerr.RequestOptions, settings config.RequestSettings) overseerr.RequestOptions {
+	if settings.ProfileID != 0 {
+		opts.ProfileID = settings.ProfileID
+	}
+	if settings.RootFolder != "" {
+		opts.RootFolder = settings.RootFolder
+	}
+	if settings.LanguageProfileID != 0 {
+		opts.LanguageProfileID = settings.LanguageProfileID
+	}
+	if len(settings.Tags) > 0 {
+		opts.Tags = settings.Tags
+	}
+	return opts
+}
+
+func (r *router) matches(ctx context.Context, rule config.RoutingRule, m routeMedia) bool {
+	if len(rule.Genres) > 0 && !containsFold(rule.Genres, m.genres...) {
+		return false
+	}
+	if len(rule.Countries) > 0 && !containsFold(rule.Countries, m.country) {
+		return false
+	}
+	if len(rule.Networks) > 0 && !containsFold(rule.Networks, m.network) {
+		return false
+	}
+	if len(rule.Languages) > 0 && !containsFold(rule.Languages, m.language) {
+		return false
+	}
+	if len(rule.Certifications) > 0 && !containsFold(rule.Certifications, m.certification) {
+		return false
+	}
+	if rule.MinYear > 0 && m.year < rule.MinYear {
+		return false
+	}
+	if rule.MaxYear > 0 && (m.year == 0 || m.year > rule.MaxYear) {
+		return false
+	}
+	if len(rule.TraktLists) > 0 && !r.lists.contains(ctx, rule.TraktLists, m) {
+		return false
+	}
+	return true
+}
+
+// containsFold reports whether any value is in the list, ignoring case
+func containsFold(list []string, values ...string) bool {
+	for _, v := range values {
+		if v == "" {
+			continue
+		}
+		for _, entry := range list {
+			if strings.EqualFold(entry, v) {
+				return true
+			}
+		}
+	}
+	return false
+}
+
+// listIndex is the Trakt list membership used by routing rules. It's
+// loaded on first use and shared by every request in a run.
+type listIndex struct {
+	accounts []*account
+	wanted   []string // List names/slugs referenced by rules
+	loaded   bool
+	shows    map[string]map[int]bool // Lower-cased list name/slug → Trakt show IDs
+	movies   map[string]map[int]bool // Lower-cased list name/slug → Trakt movie IDs
+}
+
+// newListIndex prepares the lists referenced by the given routing configs
+func newListIndex(accounts []*account, routings ...config.RoutingConfig) *listIndex {
+	l := &listIndex{accounts: accounts}
+	for _, routing := range routings {
+		for _, rule := range routing.Rules {
+			l.wanted = append(l.wanted, rule.TraktLists...)
+		}
+	}
+	return l
+}
+
+func (l *listIndex) contains(ctx context.Context, lists []string, m routeMedia) bool {
+	l.load(ctx)
+
+	index := l.shows
+	if m.movie {
+		index = l.movies
+	}
+	for _, name := range lists {
+		if index[strings.ToLower(name)][m.traktID] {
+			return true
+		}
+	}
+	return false
+}
+
+// load fetches the wanted lists of every account. Failures are logged and
+// leave the list empty, so its rules don't match.
+func (l *listIndex) load(ctx context.Context) {
+	if l.loaded {
+		return
+	}
+	l.loaded = true
+	l.shows = make(map[string]map[int]bool)
+	l.movies = make(map[string]map[int]bool)
+
+	for _, acct := range l.accounts {
+		lists, err := acct.trakt.GetLists(ctx)
+		if err != nil {
+			logger.Warnf("⚠️  Failed to get Trakt lists for %s (routing rules): %v", acct.name, err)
+			continue
+		}
+
+		for _, list := range lists {
+			if !containsFold(l.wanted, list.IDs.Slug, list.Name) {
+				continue
+			}
+			items, err := acct.trakt.GetListItems(ctx, list.IDs.Slug)
+			if err != nil {
+				logger.Warnf("⚠️  Failed to get Trakt list %s for %s (routing rules): %v", list.Name, acct.name, err)
+				continue
+			}
+			for _, item := range items {
+				switch {
+				case item.Movie != nil:
+					l.add(l.movies, list, item.Movie.IDs.Trakt)
+				case item.Show != nil:
+					l.add(l.shows, list, item.Show.IDs.Trakt)
+				}
+			}
+		}
+	}
+}
+
+func (l *listIndex) add(index map[string]map[int]bool, list trakt.List, traktID int) {
+	for _, key := range []string{strings.ToLower(list.IDs.Slug), strings.ToLower(list.Name)} {
+		if index[key] == nil {
+			index[key] = make(map[int]bool)
+		}
+		index[key][traktID] = true
+	}
+}
@@ -0,0 +1,168 @@
+package watcher
+
+import (
+	"sort"
+	"time"
+
+	"github.com/fusionn-air/internal/client/overseerr"
+	"github.com/fusionn-air/internal/client/trakt"
+)
+
+// tmdbSeason maps a Trakt season number to the season Overseerr (TMDB)
+// files the episode under. Anime often differs: absolute numbering puts
+// everything in one Trakt season, split cours become separate TMDB seasons,
+// and TVDB/TMDB disagree on where seasons start. The episode's air date is
+// matched against the premiere dates in Overseerr's season list; the latest
+// season that premiered on or before it wins. Specials are never remapped.
+func tmdbSeason(details *overseerr.TVDetails, traktSeason int, airDate time.Time) int {
+	if traktSeason == 0 || airDate.IsZero() || details == nil {
+		return traktSeason
+	}
+
+	type premiere struct {
+		number int
+		date   time.Time
+	}
+	var premieres []premiere
+	for _, season := range details.Seasons {
+		if season.SeasonNumber == 0 || season.AirDate == "" {
+			continue
+		}
+		date, err := time.Parse("2006-01-02", season.AirDate)
+		if err != nil {
+			continue
+		}
+		premieres = append(premieres, premiere{number: season.SeasonNumber, date: date})
+	}
+	if len(premieres) == 0 {
+		return traktSeason
+	}
+	sort.Slice(premieres, func(i, j int) bool { return premieres[i].date.Before(premieres[j].date) })
+
+	// Trakt air times are UTC, TMDB dates are local; allow a day of slack
+	cutoff := airDate.AddDate(0, 0, 1)
+	mapped := 0
+	for _, p := range premieres {
+		if p.date.After(cutoff) {
+			break
+		}
+		mapped = p.number
+	}
+	if mapped == 0 {
+		return traktSeason
+	}
+	return mapped
+}
+
+// airedSeasons returns the seasons in Overseerr's season list that have
+// premiered, optionally including specials
+func airedSeasons(details *overseerr.TVDetails, specials bool) []overseerr.TVSeason {
+	today := time.Now().Format("2006-01-02")
+
+	var aired []overseerr.TVSeason
+	for _, season := range details.Seasons {
+		if season.SeasonNumber == 0 && !specials {
+			continue
+		}
+		// ISO dates compare correctly as strings
+		if season.AirDate == "" || season.AirDate > today || season.EpisodeCount == 0 {
+			continue
+		}
+		aired = append(aired, season)
+	}
+	return aired
+}
+
+// seasonProgress is an account's progress on one TMDB season, with the
+// Trakt seasons its episodes came from
+type seasonProgress struct {
+	aired        int
+	completed    int
+	traktSeasons []int
+}
+
+// tmdbProgress spreads Trakt season progress over the TMDB seasons holding
+// its episodes. A Trakt season covers the TMDB seasons from the one its
+// premiere maps to up to where the next Trakt season's premiere maps; when
+// that's several (absolute numbering, split cours), its episodes are dealt
+// out in order by TMDB episode counts.
+func tmdbProgress(details *overseerr.TVDetails, progress *trakt.ShowProgress, seasons []trakt.SeasonSummary) map[int]*seasonProgress {
+	premieres := make(map[int]time.Time)
+	for _, s := range seasons {
+		if s.FirstAired != nil {
+			premieres[s.Number] = *s.FirstAired
+		}
+	}
+
+	var traktSeasons []trakt.SeasonProgress
+	for _, s := range progress.Seasons {
+		if s.Aired > 0 {
+			traktSeasons = append(traktSeasons, s)
+		}
+	}
+	sort.Slice(traktSeasons, func(i, j int) bool { return traktSeasons[i].Number < traktSeasons[j].Number })
+
+	var tmdbSeasons []overseerr.TVSeason
+	for _, s := range details.Seasons {
+		if s.SeasonNumber > 0 {
+			tmdbSeasons = append(tmdbSeasons, s)
+		}
+	}
+	sort.Slice(tmdbSeasons, func(i, j int) bool { return tmdbSeasons[i].SeasonNumber < tmdbSeasons[j].SeasonNumber })
+
+	out := make(map[int]*seasonProgress)
+	add := func(tmdb, traktSeason, aired, completed int) {
+		p := out[tmdb]
+		if p == nil {
+			p = &seasonProgress{}
+			out[tmdb] = p
+		}
+		p.aired += aired
+		p.completed += completed
+		if len(p.traktSeasons) == 0 || p.traktSeasons[len(p.traktSeasons)-1] != traktSeason {
+			p.traktSeasons = append(p.traktSeasons, traktSeason)
+		}
+	}
+
+	for i, ts := range traktSeasons {
+		start := tmdbSeason(details, ts.Number, premieres[ts.Number])
+		// Specials are never remapped or split
+		if ts.Number == 0 {
+			add(0, 0, ts.Aired, ts.Completed)
+			continue
+		}
+
+		end := -1 // No later Trakt season: runs to the last TMDB season
+		if i+1 < len(traktSeasons) {
+			next := traktSeasons[i+1].Number
+			end = tmdbSeason(details, next, premieres[next])
+		}
+		var span []overseerr.TVSeason
+		for _, s := range tmdbSeasons {
+			if s.SeasonNumber >= start && (end < 0 || s.SeasonNumber < end) {
+				span = append(span, s)
+			}
+		}
+		if len(span) <= 1 || len(ts.Episodes) == 0 {
+			add(start, ts.Number, ts.Aired, ts.Completed)
+			continue
+		}
+
+		episodes := append([]trakt.EpisodeProgress(nil), ts.Episodes...)
+		sort.Slice(episodes, func(a, b int) bool { return episodes[a].Number < episodes[b].Number })
+		j, offset := 0, 0
+		for _, ep := range episodes {
+			for j < len(span)-1 && ep.Number > offset+span[j].EpisodeCount {
+				offset += span[j].EpisodeCount
+				j++
+			}
+			completed := 0
+			if ep.Completed {
+				completed = 1
+			}
+			add(span[j].SeasonNumber, ts.Number, 1, completed)
+		}
+	}
+
+	return out
+}
@@ -11,6 +11,7 @@ import (
 	"github.com/fusionn-air/internal/client/overseerr"
 	"github.com/fusionn-air/internal/client/trakt"
 	"github.com/fusionn-air/internal/config"
+	"github.com/fusionn-air/internal/history"
 	"github.com/fusionn-air/pkg/logger"
 )
 
@@ -20,31 +21,60 @@ type Service struct {
 	overseerr *overseerr.Client
 	apprise   *apprise.Client
 	cfgMgr    *config.Manager
+	history   *history.Store
+
+	// Additional Trakt accounts whose calendars are merged with the primary one
+	traktUsers []*trakt.Client
+
+	// What cleanup deleted on purpose, so backfill leaves it alone (nil without cleanup)
+	deletions DeletionLedger
 
 	mu          sync.RWMutex
 	lastRun     time.Time
 	lastResults []ProcessResult
 }
 
-// ProcessResult holds the result of processing a single calendar item
+// ProcessResult holds the result of processing a single calendar item or movie
 type ProcessResult struct {
-	ShowTitle string    `json:"show_title"`
+	MediaType string    `json:"media_type,omitempty"` // "movie" for watchlist movies, "" for calendar seasons
+	ShowTitle string    `json:"show_title"`           // Movie title for movies
 	ShowTMDB  int       `json:"show_tmdb"`
+	Year      int       `json:"year,omitempty"` // Movies only
 	Season    int       `json:"season"`
 	Episode   int       `json:"episode"`
 	AirDate   time.Time `json:"air_date"`
-	Action    string    `json:"action"` // "requested", "skipped", "error", "already_requested", "dry_run"
+	Action    string    `json:"action"` // "requested", "skipped", "error", "already_requested", "declined", "failed", "dry_run"
 	Reason    string    `json:"reason,omitempty"`
 	Error     string    `json:"error,omitempty"`
-	Route     string    `json:"route,omitempty"` // "default", "alternate", or "" (no routing configured)
+	Route     string    `json:"route,omitempty"` // Routing rule name, "default", "alternate", or "" (no routing configured)
+	User      string    `json:"user,omitempty"`  // Trakt account whose progress qualified the season (multi-account only)
+}
+
+// label names the season or movie for summaries
+func (r ProcessResult) label() string {
+	if r.MediaType == mediaTypeMovie {
+		if r.Year > 0 {
+			return fmt.Sprintf("%s (%d)", r.ShowTitle, r.Year)
+		}
+		return r.ShowTitle
+	}
+	return fmt.Sprintf("%s S%02d", r.ShowTitle, r.Season)
 }
 
-func NewService(traktClient *trakt.Client, overseerrClient *overseerr.Client, appriseClient *apprise.Client, cfgMgr *config.Manager) *Service {
+// DeletionLedger reports seasons cleanup deleted deliberately
+type DeletionLedger interface {
+	SeasonDeleted(tvdbID, season int) bool
+}
+
+func NewService(traktClient *trakt.Client, traktUsers []*trakt.Client, overseerrClient *overseerr.Client, appriseClient *apprise.Client, cfgMgr *config.Manager, historyStore *history.Store, deletions DeletionLedger) *Service {
 	return &Service{
-		trakt:     traktClient,
-		overseerr: overseerrClient,
-		apprise:   appriseClient,
-		cfgMgr:    cfgMgr,
+		trakt:      traktClient,
+		traktUsers: traktUsers,
+		overseerr:  overseerrClient,
+		apprise:    appriseClient,
+		cfgMgr:     cfgMgr,
+		history:    historyStore,
+		deletions:  deletions,
 	}
 }
 
@@ -66,31 +96,51 @@ func (s *Service) ProcessCalendar(ctx context.Context) ([]ProcessResult, error)
 		logger.Warn("⚠️  DRY RUN MODE - No actual requests will be made")
 	}
 
-	// Get upcoming shows from Trakt calendar
-	logger.Infof("📅 Fetching calendar for next %d days...", calendarDays)
-	calendarItems, err := s.trakt.GetMyShowsCalendar(ctx, calendarDays)
+	// Get upcoming shows from every account's Trakt calendar
+	accounts := s.accounts(cfg)
+	multiUser := len(accounts) > 1
+	if multiUser {
+		logger.Infof("📅 Fetching calendars for next %d days (%s)...", calendarDays, accountNames(accounts))
+	} else {
+		logger.Infof("📅 Fetching calendar for next %d days...", calendarDays)
+	}
+
+	// Grouped by show to avoid duplicate processing
+	showSeasons, err := s.fetchCalendars(ctx, accounts, calendarDays)
 	if err != nil {
 		logger.Errorf("❌ Failed to get calendar: %v", err)
+		s.recordRun(nil, startTime, dryRun, err)
 		return nil, fmt.Errorf("getting calendar: %w", err)
 	}
 
-	if len(calendarItems) == 0 {
+	// Trakt lists for routing rules, fetched once if a rule needs them
+	lists := newListIndex(accounts, cfg.Watcher.Routing, cfg.Watcher.Movies.Routing)
+
+	var results []ProcessResult
+	if len(showSeasons) == 0 {
 		logger.Info("📭 No upcoming shows in calendar")
-		return nil, nil
-	}
+	} else {
+		logger.Infof("📺 Found %d shows with upcoming episodes", len(showSeasons))
+		logger.Info("")
 
-	// Group by show to avoid duplicate processing
-	showSeasons := s.groupByShowAndSeason(calendarItems)
-	logger.Infof("📺 Found %d shows with upcoming episodes", len(showSeasons))
-	logger.Info("")
+		shows := &router{routing: cfg.Watcher.Routing, lists: lists}
+		policy := cfg.Watcher.Policy
+		retry := cfg.Watcher.RetryFailed
 
-	var results []ProcessResult
-	routing := cfg.Watcher.Routing
+		// Process each show/season silently
+		for _, item := range showSeasons {
+			result := s.processShow(ctx, item, dryRun, shows, policy, retry, multiUser)
+			results = append(results, result)
+		}
+	}
 
-	// Process each show/season silently
-	for _, item := range showSeasons {
-		result := s.processShow(ctx, item, dryRun, routing)
-		results = append(results, result)
+	if cfg.Watcher.Movies.Enabled {
+		results = append(results, s.processMovies(ctx, cfg, accounts, lists, dryRun, multiUser)...)
+	}
+
+	if len(results) == 0 {
+		s.recordRun(nil, startTime, dryRun, nil)
+		return nil, nil
 	}
 
 	// Store results
@@ -99,11 +149,13 @@ func (s *Service) ProcessCalendar(ctx context.Context) ([]ProcessResult, error)
 	s.lastResults = results
 	s.mu.Unlock()
 
+	s.recordRun(results, startTime, dryRun, nil)
+
 	// Print summary
 	s.printSummary(results, startTime, dryRun)
 
 	// Send notification
-	s.sendNotification(ctx, results, dryRun)
+	s.sendNotification(ctx, "📺 Watcher Results", results, dryRun)
 
 	return results, nil
 }
@@ -112,20 +164,26 @@ func (s *Service) ProcessCalendar(ctx context.Context) ([]ProcessResult, error)
 func (s *Service) printSummary(results []ProcessResult, startTime time.Time, dryRun bool) {
 	var willRequest []string
 	var willSkip []string
+	var declined []string
 	var errors []string
 
 	for _, r := range results {
-		showInfo := fmt.Sprintf("%s S%02d", r.ShowTitle, r.Season)
+		showInfo := r.label()
 		routeTag := ""
 		if r.Route != "" {
 			routeTag = fmt.Sprintf(" [→ %s]", r.Route)
 		}
+		if r.User != "" {
+			routeTag += fmt.Sprintf(" [for %s]", r.User)
+		}
 		switch r.Action {
 		case "requested", "dry_run":
 			willRequest = append(willRequest, fmt.Sprintf("   • %-35s  ← %s%s", showInfo, r.Reason, routeTag))
 		case "skipped", "already_requested":
 			willSkip = append(willSkip, fmt.Sprintf("   • %-35s  ← %s", showInfo, r.Reason))
-		case "error":
+		case "declined":
+			declined = append(declined, fmt.Sprintf("   • %-35s  ← %s", showInfo, r.Reason))
+		case "error", "failed":
 			errors = append(errors, fmt.Sprintf("   • %-35s  ← %s", showInfo, r.Error))
 		}
 	}
@@ -158,6 +216,14 @@ func (s *Service) printSummary(results []ProcessResult, startTime time.Time, dry
 		}
 	}
 
+	if len(declined) > 0 {
+		logger.Info("")
+		logger.Warnf("🚫 DECLINED (%d):", len(declined))
+		for _, line := range declined {
+			logger.Warn(line)
+		}
+	}
+
 	if len(errors) > 0 {
 		logger.Info("")
 		logger.Errorf("❌ ERRORS (%d):", len(errors))
@@ -173,7 +239,7 @@ func (s *Service) printSummary(results []ProcessResult, startTime time.Time, dry
 }
 
 // sendNotification sends a notification with watcher results
-func (s *Service) sendNotification(ctx context.Context, results []ProcessResult, dryRun bool) {
+func (s *Service) sendNotification(ctx context.Context, title string, results []ProcessResult, dryRun bool) {
 	if s.apprise == nil || !s.apprise.IsEnabled() {
 		return
 	}
@@ -184,9 +250,9 @@ func (s *Service) sendNotification(ctx context.Context, results []ProcessResult,
 		switch r.Action {
 		case "requested", "dry_run":
 			requested++
-		case "skipped", "already_requested":
+		case "skipped", "already_requested", "declined":
 			skipped++
-		case "error":
+		case "error", "failed":
 			errCount++
 		}
 	}
@@ -196,18 +262,24 @@ func (s *Service) sendNotification(ctx context.Context, results []ProcessResult,
 	formatter := &apprise.SlackFormatter{}
 	var details []apprise.WatcherDetail
 	for _, r := range results {
+		reason := r.Reason
+		if r.Error != "" {
+			reason = r.Error
+		}
 		details = append(details, apprise.WatcherDetail{
+			MediaType: r.MediaType,
 			ShowTitle: r.ShowTitle,
+			Year:      r.Year,
 			Season:    r.Season,
 			Action:    r.Action,
-			Reason:    r.Reason,
+			Reason:    reason,
 			Route:     r.Route,
+			User:      r.User,
 		})
 	}
 
-	title := "📺 Watcher Results"
 	if dryRun {
-		title = "📺 Watcher Results (DRY RUN)"
+		title += " (DRY RUN)"
 	}
 
 	body := formatter.FormatWatcherResults(requested, skipped, errCount, details)
@@ -232,8 +304,8 @@ type calendarItem struct {
 	season  int
 	episode int
 	airDate time.Time
-	genres  []string
-	country string
+
+	accounts []*account // Accounts with this season on their calendar
 }
 
 func (s *Service) groupByShowAndSeason(items []trakt.CalendarShow) map[string]calendarItem {
@@ -247,8 +319,6 @@ func (s *Service) groupByShowAndSeason(items []trakt.CalendarShow) map[string]ca
 				season:  item.Episode.Season,
 				episode: item.Episode.Number,
 				airDate: item.FirstAired,
-				genres:  item.Show.Genres,
-				country: item.Show.Country,
 			}
 		}
 	}
@@ -256,7 +326,7 @@ func (s *Service) groupByShowAndSeason(items []trakt.CalendarShow) map[string]ca
 	return result
 }
 
-func (s *Service) processShow(ctx context.Context, item calendarItem, dryRun bool, routing config.RoutingConfig) ProcessResult {
+func (s *Service) processShow(ctx context.Context, item calendarItem, dryRun bool, routes *router, policy config.RequestPolicyConfig, retry config.RetryFailedConfig, multiUser bool) ProcessResult {
 	result := ProcessResult{
 		ShowTitle: item.show.Title,
 		ShowTMDB:  item.show.IDs.TMDB,
@@ -266,8 +336,8 @@ func (s *Service) processShow(ctx context.Context, item calendarItem, dryRun boo
 	}
 
 	// Determine routing
-	serverID, route := determineServerID(item.genres, item.country, routing)
-	result.Route = route
+	route := routes.route(ctx, showMedia(item.show))
+	result.Route = route.name
 
 	// Skip if no TMDB ID (can't request without it)
 	if item.show.IDs.TMDB == 0 {
@@ -276,47 +346,71 @@ func (s *Service) processShow(ctx context.Context, item calendarItem, dryRun boo
 		return result
 	}
 
-	// Get watch progress from Trakt
-	progress, err := s.trakt.GetShowProgress(ctx, item.show.IDs.Trakt)
+	// Overseerr may number the season differently (anime, split cours), so
+	// map it before judging whether it's new
+	tvDetails, err := s.overseerr.GetTVByTMDB(ctx, item.show.IDs.TMDB)
 	if err != nil {
 		result.Action = "error"
-		result.Error = fmt.Sprintf("failed to get progress: %v", err)
+		result.Error = fmt.Sprintf("Overseerr error: %v", err)
 		return result
 	}
+	season := tmdbSeason(tvDetails, item.season, item.airDate)
+
+	// Find the first account whose watch progress qualifies the season
+	var requester *account
+	var reason, skipReason string
+	for _, acct := range item.accounts {
+		progress, err := acct.trakt.GetShowProgress(ctx, item.show.IDs.Trakt)
+		if err != nil {
+			result.Action = "error"
+			result.Error = accountReason(multiUser, acct, fmt.Sprintf("failed to get progress: %v", err))
+			return result
+		}
 
-	// Get season info for total episode counts
-	seasons, err := s.trakt.GetShowSeasons(ctx, item.show.IDs.Trakt)
-	if err != nil {
-		// Non-fatal, just use aired count if we can't get total
-		seasons = nil
+		// Get season info for total episode counts
+		seasons, err := acct.trakt.GetShowSeasons(ctx, item.show.IDs.Trakt)
+		if err != nil {
+			// Non-fatal, just use aired count if we can't get total
+			seasons = nil
+		}
+
+		// Determine if we should request this season based on watch progress
+		var shouldRequest bool
+		var acctReason string
+		if season != item.season {
+			shouldRequest, acctReason = shouldRequestSplitSeason(progress, seasons, item.season, policy)
+		} else {
+			shouldRequest, acctReason = shouldRequestSeason(progress, seasons, item.season, policy)
+		}
+		if shouldRequest {
+			requester = acct
+			reason = acctReason
+			break
+		}
+		if skipReason == "" {
+			skipReason = accountReason(multiUser, acct, acctReason)
+		}
 	}
 
-	// Determine if we should request this season based on watch progress
-	shouldRequest, reason := shouldRequestSeason(progress, seasons, item.season)
-	if !shouldRequest {
-		result.Action = "skipped"
-		result.Reason = reason
-		return result
+	if season != item.season {
+		if requester != nil {
+			reason = fmt.Sprintf("%s (TMDB S%02d)", reason, season)
+		} else {
+			skipReason = fmt.Sprintf("%s (TMDB S%02d)", skipReason, season)
+		}
 	}
 
-	// Check Overseerr if already requested/available
-	tvDetails, err := s.overseerr.GetTVByTMDB(ctx, item.show.IDs.TMDB)
-	if err != nil {
-		result.Action = "error"
-		result.Error = fmt.Sprintf("Overseerr error: %v", err)
+	if requester == nil {
+		result.Action = "skipped"
+		result.Reason = skipReason
 		return result
 	}
+	if multiUser {
+		result.User = requester.name
+	}
 
-	requestInfo := s.overseerr.GetSeasonRequestInfo(tvDetails, item.season)
-	if requestInfo.Requested {
-		result.Action = "already_requested"
-		if requestInfo.RequestedBy != "" {
-			result.Reason = fmt.Sprintf("already requested by %s", requestInfo.RequestedBy)
-		} else if requestInfo.Status >= 4 { // Available or partially available
-			result.Reason = "already available in Overseerr"
-		} else {
-			result.Reason = "already requested in Overseerr"
-		}
+	requestInfo := s.overseerr.GetSeasonRequestInfo(tvDetails, season)
+	if s.existingRequest(ctx, requestInfo, retry, dryRun, &result) {
 		return result
 	}
 
@@ -328,7 +422,7 @@ func (s *Service) processShow(ctx context.Context, item calendarItem, dryRun boo
 	}
 
 	// Request the season with routing
-	_, err = s.overseerr.RequestTV(ctx, item.show.IDs.TMDB, []int{item.season}, serverID)
+	_, err = s.overseerr.RequestTVAs(ctx, requester.overseerrUserID, item.show.IDs.TMDB, []int{season}, route.opts)
 	if err != nil {
 		result.Action = "error"
 		result.Error = fmt.Sprintf("request failed: %v", err)
@@ -340,8 +434,9 @@ func (s *Service) processShow(ctx context.Context, item calendarItem, dryRun boo
 	return result
 }
 
-// determineServerID checks show genres and country against routing config
-// to decide which Overseerr backend server should handle the request.
+// determineServerID checks show genres and country against the alternate
+// server settings to decide which Overseerr backend server should handle
+// requests that no routing rule matched.
 func determineServerID(genres []string, country string, routing config.RoutingConfig) (*int, string) {
 	// If no routing rules are configured, don't set a server ID
 	if len(routing.AlternateGenres) == 0 && len(routing.AlternateCountries) == 0 {
@@ -372,7 +467,7 @@ func determineServerID(genres []string, country string, routing config.RoutingCo
 }
 
 // shouldRequestSeason determines if a season should be requested based on watch progress
-func shouldRequestSeason(progress *trakt.ShowProgress, seasons []trakt.SeasonSummary, targetSeason int) (bool, string) {
+func shouldRequestSeason(progress *trakt.ShowProgress, seasons []trakt.SeasonSummary, targetSeason int, policy config.RequestPolicyConfig) (bool, string) {
 	// Build map of total episode counts per season
 	totalEps := make(map[int]int)
 	for _, s := range seasons {
@@ -388,6 +483,17 @@ func shouldRequestSeason(progress *trakt.ShowProgress, seasons []trakt.SeasonSum
 		}
 	}
 
+	// Specials are only requested when enabled, for shows being watched
+	if targetSeason == 0 {
+		if !policy.Specials {
+			return false, "specials not requested (request_policy.specials)"
+		}
+		if progress.Completed == 0 {
+			return false, "no watch history"
+		}
+		return true, "special of a show being watched"
+	}
+
 	// If user has already watched any episodes of target season, it's already available
 	if targetSeasonProgress != nil && targetSeasonProgress.Completed > 0 {
 		total := totalEps[targetSeason]
@@ -421,7 +527,14 @@ func shouldRequestSeason(progress *trakt.ShowProgress, seasons []trakt.SeasonSum
 		return false, "no watch history"
 	}
 
-	// For season 2+, check if previous season is complete
+	// For season 2+, request anyway if the show is being actively watched
+	if policy.ActiveDays > 0 && progress.LastWatchedAt != nil {
+		if days := int(time.Since(*progress.LastWatchedAt).Hours() / 24); days < policy.ActiveDays {
+			return true, fmt.Sprintf("actively watching (last watched %s)", progress.LastWatchedAt.Format("2006-01-02"))
+		}
+	}
+
+	// Otherwise check if previous season is complete (or close enough)
 	prevSeason := targetSeason - 1
 	var prevSeasonProgress *trakt.SeasonProgress
 	for i := range progress.Seasons {
@@ -444,6 +557,10 @@ func shouldRequestSeason(progress *trakt.ShowProgress, seasons []trakt.SeasonSum
 		if total == 0 {
 			total = prevSeasonProgress.Aired
 		}
+		if nearlyComplete(prevSeasonProgress, policy) {
+			return true, fmt.Sprintf("S%02d nearly complete (%d/%d eps, %d aired)",
+				prevSeason, prevSeasonProgress.Completed, total, prevSeasonProgress.Aired)
+		}
 		return false, fmt.Sprintf("S%02d incomplete (%d/%d eps, %d aired)",
 			prevSeason, prevSeasonProgress.Completed, total, prevSeasonProgress.Aired)
 	}
@@ -451,6 +568,56 @@ func shouldRequestSeason(progress *trakt.ShowProgress, seasons []trakt.SeasonSum
 	return true, fmt.Sprintf("S%02d complete", prevSeason)
 }
 
+// shouldRequestSplitSeason decides on a calendar episode that Overseerr
+// files under a different season than Trakt does, e.g. the second cour of
+// a Trakt season. Episodes of the Trakt season that already aired belong to
+// the seasons before it, so that progress counts as the previous season's.
+// A Trakt season with nothing aired yet is judged as a new season as usual.
+func shouldRequestSplitSeason(progress *trakt.ShowProgress, seasons []trakt.SeasonSummary, traktSeason int, policy config.RequestPolicyConfig) (bool, string) {
+	var current *trakt.SeasonProgress
+	for i := range progress.Seasons {
+		if progress.Seasons[i].Number == traktSeason {
+			current = &progress.Seasons[i]
+			break
+		}
+	}
+	if current == nil || current.Aired == 0 {
+		return shouldRequestSeason(progress, seasons, traktSeason, policy)
+	}
+
+	// Request anyway if the show is being actively watched
+	if policy.ActiveDays > 0 && progress.LastWatchedAt != nil {
+		if days := int(time.Since(*progress.LastWatchedAt).Hours() / 24); days < policy.ActiveDays {
+			return true, fmt.Sprintf("actively watching (last watched %s)", progress.LastWatchedAt.Format("2006-01-02"))
+		}
+	}
+
+	if current.Completed == 0 {
+		return false, fmt.Sprintf("S%02d not watched", traktSeason)
+	}
+	if current.Completed >= current.Aired {
+		return true, fmt.Sprintf("S%02d aired episodes complete (%d/%d)", traktSeason, current.Completed, current.Aired)
+	}
+	if nearlyComplete(current, policy) {
+		return true, fmt.Sprintf("S%02d nearly complete (%d/%d aired eps)", traktSeason, current.Completed, current.Aired)
+	}
+	return false, fmt.Sprintf("S%02d incomplete (%d/%d aired eps)", traktSeason, current.Completed, current.Aired)
+}
+
+// nearlyComplete reports whether an unfinished season is within the
+// policy's episode or percentage threshold of being finished. A season
+// nobody has started is never nearly complete, however short it is.
+func nearlyComplete(season *trakt.SeasonProgress, policy config.RequestPolicyConfig) bool {
+	if season.Completed == 0 {
+		return false
+	}
+	remaining := season.Aired - season.Completed
+	if policy.EpisodesRemaining > 0 && remaining <= policy.EpisodesRemaining {
+		return true
+	}
+	return policy.PercentRemaining > 0 && remaining*100 <= policy.PercentRemaining*season.Aired
+}
+
 // GetLastRun returns the last run time and results
 func (s *Service) GetLastRun() (time.Time, []ProcessResult) {
 	s.mu.RLock()
@@ -464,6 +631,7 @@ type Stats struct {
 	TotalShows int             `json:"total_shows"`
 	Requested  int             `json:"requested"`
 	Skipped    int             `json:"skipped"`
+	Declined   int             `json:"declined"`
 	Errors     int             `json:"errors"`
 	Results    []ProcessResult `json:"results,omitempty"`
 }
@@ -484,10 +652,50 @@ func (s *Service) GetStats() Stats {
 			stats.Requested++
 		case "skipped", "already_requested":
 			stats.Skipped++
-		case "error":
+		case "declined":
+			stats.Declined++
+		case "error", "failed":
 			stats.Errors++
 		}
 	}
 
 	return stats
 }
+
+// recordRun saves a watcher run to the history store
+func (s *Service) recordRun(results []ProcessResult, startTime time.Time, dryRun bool, runErr error) {
+	run := &history.Run{
+		Service:    history.ServiceWatcher,
+		StartedAt:  startTime,
+		FinishedAt: time.Now(),
+		DryRun:     dryRun,
+		Summary:    make(map[string]int),
+	}
+	if runErr != nil {
+		run.Error = runErr.Error()
+	}
+	for _, r := range results {
+		run.Summary[r.Action]++
+		reason := r.Reason
+		if r.Error != "" {
+			reason = r.Error
+		}
+		itemType := "season"
+		if r.MediaType == mediaTypeMovie {
+			itemType = mediaTypeMovie
+		}
+		run.Items = append(run.Items, history.Item{
+			Type:   itemType,
+			Title:  r.ShowTitle,
+			TmdbID: r.ShowTMDB,
+			Season: r.Season,
+			Action: r.Action,
+			Reason: reason,
+			User:   r.User,
+		})
+	}
+
+	if err := s.history.Record(run); err != nil {
+		logger.Warnf("⚠️  Failed to save run history: %v", err)
+	}
+}